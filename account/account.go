@@ -354,23 +354,34 @@ func (a *Service) calculateAccount() (*data.Account, error) {
 		return nil, err
 	}
 	onChainBalance := walletBalance.ConfirmedBalance
+	anchorReserve, err := a.anchorReserve()
+	if err != nil {
+		a.log.Errorf("failed to get the anchor reserve, showing all the wallet balance as spendable: %v", err)
+		anchorReserve = 0
+	}
+	walletReserve := int64(anchorReserve)
+	if walletReserve > onChainBalance {
+		walletReserve = onChainBalance
+	}
 	return &data.Account{
-		Id:                  lnInfo.IdentityPubkey,
-		Balance:             normalizedBalance,
-		MaxAllowedToReceive: maxAllowedToReceive,
-		MaxAllowedToPay:     maxAllowedToPay,
-		MaxPaymentAmount:    maxPaymentAllowedSat,
-		MaxChanReserve:      maxChanReserve,
-		Status:              accStatus,
-		WalletBalance:       onChainBalance,
-		RoutingNodeFee:      routingNodeFeeRate,
-		ReadyForPayments:    a.daemonAPI.HasActiveChannel(),
-		Enabled:             enabled,
-		ChannelPoint:        chanPoint,
-		TipHeight:           int64(lnInfo.BlockHeight),
-		ConnectedPeers:      connectedPeers,
-		MaxInboundLiquidity: maxInboundLiquidity,
-		UnconfirmedChannels: unconfirmedChannels,
+		Id:                     lnInfo.IdentityPubkey,
+		Balance:                normalizedBalance,
+		MaxAllowedToReceive:    maxAllowedToReceive,
+		MaxAllowedToPay:        maxAllowedToPay,
+		MaxPaymentAmount:       maxPaymentAllowedSat,
+		MaxChanReserve:         maxChanReserve,
+		Status:                 accStatus,
+		WalletBalance:          onChainBalance,
+		RoutingNodeFee:         routingNodeFeeRate,
+		ReadyForPayments:       a.daemonAPI.HasActiveChannel(),
+		Enabled:                enabled,
+		ChannelPoint:           chanPoint,
+		TipHeight:              int64(lnInfo.BlockHeight),
+		ConnectedPeers:         connectedPeers,
+		MaxInboundLiquidity:    maxInboundLiquidity,
+		UnconfirmedChannels:    unconfirmedChannels,
+		ReservedWalletBalance:  walletReserve,
+		SpendableWalletBalance: onChainBalance - walletReserve,
 	}, nil
 }
 
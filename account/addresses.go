@@ -0,0 +1,125 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// The address types supported by NewAddress.
+const (
+	AddressTypeP2WKH  = "p2wkh"
+	AddressTypeNP2WKH = "np2wkh"
+	AddressTypeP2TR   = "p2tr"
+)
+
+/*
+NewAddress returns an onchain address of addressType to receive funds. The
+last address of that type handed out is returned again until it receives
+funds, so the app doesn't reuse addresses nor leave gaps in the wallet.
+*/
+func (a *Service) NewAddress(addressType string) (string, error) {
+	var lnType lnrpc.AddressType
+	switch addressType {
+	case AddressTypeP2WKH, "":
+		addressType, lnType = AddressTypeP2WKH, lnrpc.AddressType_WITNESS_PUBKEY_HASH
+	case AddressTypeNP2WKH:
+		lnType = lnrpc.AddressType_NESTED_PUBKEY_HASH
+	case AddressTypeP2TR:
+		// The lnd we embed doesn't support taproot (0.15+).
+		return "", fmt.Errorf("address type %v is not supported", addressType)
+	default:
+		return "", fmt.Errorf("unknown address type %v", addressType)
+	}
+
+	addresses, err := a.OnchainAddresses()
+	if err != nil {
+		return "", err
+	}
+	for _, address := range addresses.Addresses {
+		if address.Type == addressType {
+			if !address.Used {
+				return address.Address, nil
+			}
+			break
+		}
+	}
+
+	lnClient := a.daemonAPI.APIClient()
+	res, err := lnClient.NewAddress(context.Background(), &lnrpc.NewAddressRequest{Type: lnType})
+	if err != nil {
+		return "", fmt.Errorf("lnClient.NewAddress: %w", err)
+	}
+	err = a.breezDB.SaveOnchainAddress(&data.OnchainAddress{
+		Address: res.Address,
+		Type:    addressType,
+		Created: time.Now().Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.Address, nil
+}
+
+// OnchainAddresses returns the addresses handed out by NewAddress, newest
+// first, and whether they received funds.
+func (a *Service) OnchainAddresses() (*data.OnchainAddresses, error) {
+	lnClient := a.daemonAPI.APIClient()
+	if lnClient == nil {
+		return nil, errors.New("daemon is not ready")
+	}
+	addresses, err := a.breezDB.FetchOnchainAddresses()
+	if err != nil {
+		return nil, err
+	}
+	txs, err := lnClient.GetTransactions(context.Background(), &lnrpc.GetTransactionsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("lnClient.GetTransactions: %w", err)
+	}
+	used := make(map[string]bool)
+	for _, tx := range txs.Transactions {
+		for _, address := range tx.DestAddresses {
+			used[address] = true
+		}
+	}
+	for _, address := range addresses {
+		address.Used = used[address.Address]
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return addresses[i].Created > addresses[j].Created
+	})
+	return &data.OnchainAddresses{Addresses: addresses}, nil
+}
+
+// walletAddresses returns the addresses known to belong to the lnd wallet:
+// the ones handed out by NewAddress and the ones holding unspent outputs.
+func (a *Service) walletAddresses() (map[string]struct{}, error) {
+	addresses, err := a.breezDB.FetchOnchainAddresses()
+	if err != nil {
+		return nil, err
+	}
+	lnClient := a.daemonAPI.APIClient()
+	if lnClient == nil {
+		return nil, errors.New("daemon is not ready")
+	}
+	unspent, err := lnClient.ListUnspent(context.Background(), &lnrpc.ListUnspentRequest{
+		MinConfs: 0, MaxConfs: math.MaxInt32,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("lnClient.ListUnspent: %w", err)
+	}
+	own := make(map[string]struct{}, len(addresses)+len(unspent.Utxos))
+	for _, handedOut := range addresses {
+		own[handedOut.Address] = struct{}{}
+	}
+	for _, utxo := range unspent.Utxos {
+		own[utxo.Address] = struct{}{}
+	}
+	return own, nil
+}
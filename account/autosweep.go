@@ -0,0 +1,128 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+const (
+	autoSweepInterval          = 10 * time.Minute
+	defaultAutoSweepConfTarget = 6
+	autoSweepLabel             = "auto sweep"
+)
+
+/*
+SetAutoSweepPolicy sets the policy used to sweep all the wallet coins to the
+policy address once the fee estimate for the policy conf target drops to
+MaxSatPerVbyte or below. A nil policy disables the auto sweep.
+*/
+func (a *Service) SetAutoSweepPolicy(policy *data.AutoSweepPolicy) error {
+	if policy != nil {
+		if _, err := a.sweepTargetAddress(policy.Address); err != nil {
+			return err
+		}
+		if policy.MaxSatPerVbyte <= 0 {
+			return fmt.Errorf("invalid fee rate %v", policy.MaxSatPerVbyte)
+		}
+		if policy.ConfTarget <= 0 {
+			policy.ConfTarget = defaultAutoSweepConfTarget
+		}
+	}
+	return a.breezDB.SetAutoSweepPolicy(policy)
+}
+
+// AutoSweepPolicy returns the current auto sweep policy. An empty policy
+// means the auto sweep is disabled.
+func (a *Service) AutoSweepPolicy() (*data.AutoSweepPolicy, error) {
+	policy, err := a.breezDB.GetAutoSweepPolicy()
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		return &data.AutoSweepPolicy{}, nil
+	}
+	return policy, nil
+}
+
+func (a *Service) watchAutoSweep() {
+	defer a.wg.Done()
+
+	ticker := time.NewTicker(autoSweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if a.daemonRPCReady() {
+				a.checkAutoSweep()
+			}
+		case <-a.quitChan:
+			return
+		}
+	}
+}
+
+// checkAutoSweep sweeps the confirmed wallet coins if the auto sweep policy
+// conditions are met.
+func (a *Service) checkAutoSweep() {
+	policy, err := a.breezDB.GetAutoSweepPolicy()
+	if err != nil {
+		a.log.Errorf("breezDB.GetAutoSweepPolicy: %v", err)
+		return
+	}
+	if policy == nil {
+		return
+	}
+
+	feePerKw, err := a.determineFeePerKw(int(policy.ConfTarget))
+	if err != nil {
+		a.log.Errorf("checkAutoSweep: %v", err)
+		return
+	}
+	satPerVbyte := int64(feePerKw.FeePerKVByte()+999) / 1000
+	if satPerVbyte > policy.MaxSatPerVbyte {
+		a.log.Debugf("checkAutoSweep: fee rate %v sat/vbyte is above %v", satPerVbyte, policy.MaxSatPerVbyte)
+		return
+	}
+
+	balance, err := a.daemonAPI.APIClient().WalletBalance(context.Background(), &lnrpc.WalletBalanceRequest{})
+	if err != nil {
+		a.log.Errorf("lnClient.WalletBalance: %v", err)
+		return
+	}
+	reserve, err := a.anchorReserve()
+	if err != nil {
+		a.log.Errorf("checkAutoSweep: %v", err)
+		return
+	}
+	// The anchor reserve stays in the wallet.
+	if balance.ConfirmedBalance <= int64(reserve) {
+		return
+	}
+
+	a.log.Infof("checkAutoSweep: sweeping %v to %v at %v sat/vbyte",
+		balance.ConfirmedBalance-int64(reserve), policy.Address, satPerVbyte)
+	sweepTx, err := a.SweepCoinsTransaction(&data.SweepCoinsRequest{
+		Address:     policy.Address,
+		SatPerVbyte: satPerVbyte,
+	})
+	if err == nil {
+		err = a.PublishTransactionWithLabel(sweepTx.Transaction.Tx, autoSweepLabel)
+	}
+	if err != nil {
+		a.log.Errorf("checkAutoSweep: failed to sweep: %v", err)
+		a.onServiceEvent(data.NotificationEvent{
+			Type: data.NotificationEvent_AUTO_SWEEP_FAILED,
+			Data: []string{err.Error()},
+		})
+		return
+	}
+	a.onServiceEvent(data.NotificationEvent{
+		Type: data.NotificationEvent_AUTO_SWEEP_EXECUTED,
+		Data: []string{sweepTx.Transaction.TxHash, strconv.FormatInt(sweepTx.Amt, 10)},
+	})
+}
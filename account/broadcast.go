@@ -0,0 +1,54 @@
+package account
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	broadcastTimeout = 30 * time.Second
+)
+
+// broadcastExternally pushes the raw transaction to the esplora compatible
+// broadcasters configured using broadcasturl, so it propagates even if the
+// node has few peers. Failures are only logged since the transaction was
+// already published by the node.
+func (a *Service) broadcastExternally(rawTx []byte) {
+	if len(a.cfg.BroadcastCfg.URLs) == 0 {
+		return
+	}
+	txHex := hex.EncodeToString(rawTx)
+	client := &http.Client{Timeout: broadcastTimeout}
+	for _, url := range a.cfg.BroadcastCfg.URLs {
+		go func(url string) {
+			txid, err := broadcastTx(client, url, txHex)
+			if err != nil {
+				a.log.Errorf("broadcastExternally(%v): %v", url, err)
+				return
+			}
+			a.log.Infof("broadcastExternally: %v broadcast %v", url, txid)
+		}(url)
+	}
+}
+
+// broadcastTx posts the hex encoded transaction to url and returns the txid
+// in the response.
+func broadcastTx(client *http.Client, url, txHex string) (string, error) {
+	resp, err := client.Post(url, "text/plain", strings.NewReader(txHex))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(http.MaxBytesReader(nil, resp.Body, 4096))
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("status %v: %s", resp.StatusCode, body)
+	}
+	return strings.TrimSpace(string(body)), nil
+}
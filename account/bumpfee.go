@@ -0,0 +1,217 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/breez/breez/data"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// incrementalRelayFee is the default fee rate the nodes require a replacement
+// to pay on top of the fee of the replaced transaction.
+const incrementalRelayFee = chainfee.SatPerKVByte(1000)
+
+/*
+BumpFee crafts a replacement (BIP125) for the unconfirmed sweep transaction txid
+spending the same inputs to the same address with a fee rate of satPerVbyte.
+The replacement should be published using PublishTransaction.
+Sweeps crafted by this wallet are re-signed from their saved package, only
+lowering the output that pays the fee.
+*/
+func (a *Service) BumpFee(txid string, satPerVbyte int64) (*data.SweepCoinsTransaction, error) {
+	lnClient := a.daemonAPI.APIClient()
+	if lnClient == nil {
+		return nil, errors.New("daemon is not ready")
+	}
+	txs, err := lnClient.GetTransactions(context.Background(), &lnrpc.GetTransactionsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("lnClient.GetTransactions: %w", err)
+	}
+	walletTxs := make(map[string]*lnrpc.Transaction)
+	for _, tx := range txs.Transactions {
+		walletTxs[tx.TxHash] = tx
+	}
+
+	walletTx, ok := walletTxs[txid]
+	if !ok {
+		return nil, fmt.Errorf("transaction %v not found", txid)
+	}
+	if walletTx.NumConfirmations > 0 {
+		return nil, fmt.Errorf("transaction %v is already confirmed", txid)
+	}
+	feePerKw := chainfee.SatPerKVByte(satPerVbyte * 1000).FeePerKWeight()
+
+	// Sweeps we crafted are re-signed from their saved package.
+	pkg, err := a.breezDB.FetchSweepPackage(txid)
+	if err != nil {
+		return nil, err
+	}
+	if pkg != nil {
+		details, amount, err := a.resignSweepPackage(pkg, feePerKw)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkReplacementFee(details, sweepPackageFee(pkg)); err != nil {
+			return nil, err
+		}
+		return &data.SweepCoinsTransaction{Amt: amount, Transaction: details}, nil
+	}
+
+	tx, err := decodeWalletTx(walletTx)
+	if err != nil {
+		return nil, err
+	}
+	if len(tx.TxOut) != 1 {
+		return nil, fmt.Errorf("transaction %v is not a sweep", txid)
+	}
+	_, addresses, _, err := txscript.ExtractPkScriptAddrs(tx.TxOut[0].PkScript, a.activeParams)
+	if err != nil || len(addresses) != 1 {
+		return nil, fmt.Errorf("failed to extract the sweep address of %v", txid)
+	}
+
+	utxos, err := a.txInputsUtxos(tx, walletTxs)
+	if err != nil {
+		return nil, err
+	}
+	var totalIn int64
+	for _, utxo := range utxos {
+		totalIn += int64(utxo.Value)
+	}
+	oldFee := totalIn - tx.TxOut[0].Value
+
+	info, err := lnClient.GetInfo(context.Background(), &lnrpc.GetInfoRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("lnClient.GetInfo: %w", err)
+	}
+	details, amount, err := a.craftSweepAllTx(addresses[0], nil, feePerKw, info.BlockHeight, newFixedUtxoSource(utxos), false)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkReplacementFee(details, oldFee); err != nil {
+		return nil, err
+	}
+	return &data.SweepCoinsTransaction{Amt: amount, Transaction: details}, nil
+}
+
+// checkReplacementFee checks that the replacement pays oldFee plus its own
+// relay at the incremental relay fee rate, as required by BIP125.
+func checkReplacementFee(details *data.TransactionDetails, oldFee int64) error {
+	minFee := oldFee + int64(incrementalRelayFee.FeeForVSize(details.Vsize))
+	if details.Fees < minFee {
+		return fmt.Errorf("the new fee %v must be at least %v, the current fee %v plus %v for relaying the replacement",
+			details.Fees, minFee, oldFee, minFee-oldFee)
+	}
+	return nil
+}
+
+func decodeWalletTx(walletTx *lnrpc.Transaction) (*wire.MsgTx, error) {
+	rawTx, err := hex.DecodeString(walletTx.RawTxHex)
+	if err != nil {
+		return nil, fmt.Errorf("hex.DecodeString(%v): %w", walletTx.RawTxHex, err)
+	}
+	tx := &wire.MsgTx{}
+	if err := tx.Deserialize(bytes.NewReader(rawTx)); err != nil {
+		return nil, fmt.Errorf("tx.Deserialize: %w", err)
+	}
+	return tx, nil
+}
+
+// txInputsUtxos returns the outputs spent by tx. The outputs must belong to
+// the wallet transactions walletTxs.
+func (a *Service) txInputsUtxos(tx *wire.MsgTx, walletTxs map[string]*lnrpc.Transaction) ([]*lnwallet.Utxo, error) {
+	var utxos []*lnwallet.Utxo
+	for _, txIn := range tx.TxIn {
+		prevOut := txIn.PreviousOutPoint
+		walletTx, ok := walletTxs[prevOut.Hash.String()]
+		if !ok {
+			return nil, fmt.Errorf("input %v is not a wallet output", prevOut)
+		}
+		parent, err := decodeWalletTx(walletTx)
+		if err != nil {
+			return nil, err
+		}
+		if int(prevOut.Index) >= len(parent.TxOut) {
+			return nil, fmt.Errorf("invalid input %v", prevOut)
+		}
+		utxo, err := walletUtxo(prevOut, parent.TxOut[prevOut.Index], walletTx.NumConfirmations)
+		if err != nil {
+			return nil, err
+		}
+		utxos = append(utxos, utxo)
+	}
+	return utxos, nil
+}
+
+func walletUtxo(outPoint wire.OutPoint, txOut *wire.TxOut, confirmations int32) (*lnwallet.Utxo, error) {
+	var addrType lnwallet.AddressType
+	switch {
+	case txscript.IsPayToWitnessPubKeyHash(txOut.PkScript):
+		addrType = lnwallet.WitnessPubKey
+	case txscript.IsPayToScriptHash(txOut.PkScript):
+		addrType = lnwallet.NestedWitnessPubKey
+	default:
+		return nil, fmt.Errorf("unsupported output type of %v", outPoint)
+	}
+	return &lnwallet.Utxo{
+		AddressType:   addrType,
+		Value:         btcutil.Amount(txOut.Value),
+		Confirmations: int64(confirmations),
+		PkScript:      txOut.PkScript,
+		OutPoint:      outPoint,
+	}, nil
+}
+
+/*
+CPFP accelerates the unconfirmed transaction txid by spending the wallet
+outputs it created with a child transaction paying satPerVbyte.
+*/
+func (a *Service) CPFP(txid string, satPerVbyte int64) error {
+	lnClient := a.daemonAPI.APIClient()
+	walletKitClient := a.daemonAPI.WalletKitClient()
+	if lnClient == nil || walletKitClient == nil {
+		return errors.New("daemon is not ready")
+	}
+	if satPerVbyte <= 0 {
+		return fmt.Errorf("invalid fee rate %v", satPerVbyte)
+	}
+	unspent, err := lnClient.ListUnspent(context.Background(), &lnrpc.ListUnspentRequest{
+		MinConfs: 0, MaxConfs: 0,
+	})
+	if err != nil {
+		return fmt.Errorf("lnClient.ListUnspent: %w", err)
+	}
+
+	var outpoint *lnrpc.OutPoint
+	var amount int64
+	for _, utxo := range unspent.Utxos {
+		if utxo.Outpoint.TxidStr == txid && utxo.AmountSat > amount {
+			outpoint, amount = utxo.Outpoint, utxo.AmountSat
+		}
+	}
+	if outpoint == nil {
+		return fmt.Errorf("no unconfirmed wallet output in transaction %v", txid)
+	}
+
+	a.log.Infof("CPFP: spending %v:%v with %v sat/vbyte", outpoint.TxidStr, outpoint.OutputIndex, satPerVbyte)
+	_, err = walletKitClient.BumpFee(context.Background(), &walletrpc.BumpFeeRequest{
+		Outpoint: &lnrpc.OutPoint{
+			TxidBytes:   outpoint.TxidBytes,
+			OutputIndex: outpoint.OutputIndex,
+		},
+		SatPerByte: uint32(satPerVbyte),
+	})
+	if err != nil {
+		return fmt.Errorf("walletKitClient.BumpFee: %w", err)
+	}
+	return nil
+}
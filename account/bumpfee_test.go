@@ -0,0 +1,27 @@
+package account
+
+import (
+	"testing"
+
+	"github.com/breez/breez/data"
+)
+
+func TestCheckReplacementFee(t *testing.T) {
+	tests := []struct {
+		fees  int64
+		valid bool
+	}{
+		{fees: 1000, valid: false},
+		{fees: 1100, valid: false},
+		{fees: 1140, valid: false},
+		{fees: 1141, valid: true},
+		{fees: 2000, valid: true},
+	}
+	for _, test := range tests {
+		details := &data.TransactionDetails{Fees: test.fees, Vsize: 141}
+		err := checkReplacementFee(details, 1000)
+		if (err == nil) != test.valid {
+			t.Errorf("checkReplacementFee(%v) = %v, want valid %v", test.fees, err, test.valid)
+		}
+	}
+}
@@ -8,8 +8,6 @@ import (
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/lnrpc"
-	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
-	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 const (
@@ -62,16 +60,11 @@ func (a *Service) SendWalletCoins(address string, satPerByteFee int64) (string,
 GetDefaultSatPerByteFee returns the default sat per byte fee for on chain transactions
 */
 func (a *Service) GetDefaultSatPerByteFee() (int64, error) {
-	walletKityClient := a.daemonAPI.WalletKitClient()
-	if walletKityClient == nil {
-		return 0, errors.New("API not ready")
-	}
-	feeResponse, err := walletKityClient.EstimateFee(context.Background(),
-		&walletrpc.EstimateFeeRequest{ConfTarget: 6})
+	estimates, err := a.FeeEstimates()
 	if err != nil {
 		return 0, err
 	}
-	return int64(chainfee.SatPerKWeight(feeResponse.SatPerKw).FeePerKVByte() / 1000), nil
+	return estimates.Medium, nil
 }
 
 /*
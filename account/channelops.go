@@ -0,0 +1,145 @@
+package account
+
+import (
+	"context"
+
+	"github.com/breez/breez/data"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// streamEndedByDaemon returns true if the update stream of a channel opened or
+// closed by us ended because the daemon stopped, and not because the channel
+// operation failed.
+func (a *Service) streamEndedByDaemon(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.Canceled:
+		return true
+	}
+	return !a.daemonRPCReady()
+}
+
+// trackOpenAfterRestart keeps the channel being opened so CHANNEL_OPENED is
+// still notified once the daemon is restarted.
+func (a *Service) trackOpenAfterRestart(channelPoint, pubkey string) {
+	a.channelOpsMu.Lock()
+	defer a.channelOpsMu.Unlock()
+	if a.orphanedOpens == nil {
+		a.orphanedOpens = make(map[string]string)
+	}
+	a.orphanedOpens[channelPoint] = pubkey
+}
+
+// trackCloseAfterRestart keeps the channel being closed so
+// CHANNEL_CLOSE_CONFIRMED is still notified once the daemon is restarted.
+func (a *Service) trackCloseAfterRestart(channelPoint, closingTxid string) {
+	a.channelOpsMu.Lock()
+	defer a.channelOpsMu.Unlock()
+	if a.orphanedCloses == nil {
+		a.orphanedCloses = make(map[string]string)
+	}
+	a.orphanedCloses[channelPoint] = closingTxid
+}
+
+// channelOpEvent is the notification of a tracked channel operation that
+// completed.
+type channelOpEvent struct {
+	eventType data.NotificationEvent_NotificationType
+	data      []string
+}
+
+func (a *Service) notifyChannelOps(events []channelOpEvent) {
+	for _, e := range events {
+		a.onServiceEvent(data.NotificationEvent{Type: e.eventType, Data: e.data})
+	}
+}
+
+// channelOpened stops tracking the opening of channelPoint and returns its
+// notification, if it was tracked.
+func (a *Service) channelOpened(channelPoint string) []channelOpEvent {
+	a.channelOpsMu.Lock()
+	pubkey, ok := a.orphanedOpens[channelPoint]
+	delete(a.orphanedOpens, channelPoint)
+	a.channelOpsMu.Unlock()
+	if !ok {
+		return nil
+	}
+	a.log.Infof("channel %v opened", channelPoint)
+	return []channelOpEvent{{
+		eventType: data.NotificationEvent_CHANNEL_OPENED,
+		data:      []string{channelPoint, pubkey},
+	}}
+}
+
+// channelClosed stops tracking the closing of channelPoint and returns its
+// notification, if it was tracked.
+func (a *Service) channelClosed(channelPoint string) []channelOpEvent {
+	a.channelOpsMu.Lock()
+	closingTxid, ok := a.orphanedCloses[channelPoint]
+	delete(a.orphanedCloses, channelPoint)
+	a.channelOpsMu.Unlock()
+	if !ok {
+		return nil
+	}
+	a.log.Infof("channel %v close confirmed", channelPoint)
+	return []channelOpEvent{{
+		eventType: data.NotificationEvent_CHANNEL_CLOSE_CONFIRMED,
+		data:      []string{channelPoint, closingTxid},
+	}}
+}
+
+// onTrackedChannelEvent notifies the completion of the tracked channel
+// operations reported by the channel events of the restarted daemon.
+func (a *Service) onTrackedChannelEvent(update *lnrpc.ChannelEventUpdate) {
+	a.notifyChannelOps(a.trackedChannelEvent(update))
+}
+
+func (a *Service) trackedChannelEvent(update *lnrpc.ChannelEventUpdate) []channelOpEvent {
+	switch update.Type {
+	case lnrpc.ChannelEventUpdate_OPEN_CHANNEL:
+		if c := update.GetOpenChannel(); c != nil {
+			return a.channelOpened(c.ChannelPoint)
+		}
+	case lnrpc.ChannelEventUpdate_CLOSED_CHANNEL:
+		if c := update.GetClosedChannel(); c != nil {
+			return a.channelClosed(c.ChannelPoint)
+		}
+	}
+	return nil
+}
+
+// checkTrackedChannels notifies the tracked channel operations that completed
+// while the daemon was down.
+func (a *Service) checkTrackedChannels() {
+	a.notifyChannelOps(a.completedChannelOps())
+}
+
+func (a *Service) completedChannelOps() []channelOpEvent {
+	a.channelOpsMu.Lock()
+	tracked := len(a.orphanedOpens) + len(a.orphanedCloses)
+	a.channelOpsMu.Unlock()
+	if tracked == 0 {
+		return nil
+	}
+
+	var events []channelOpEvent
+	lnclient := a.daemonAPI.APIClient()
+	channels, err := lnclient.ListChannels(context.Background(), &lnrpc.ListChannelsRequest{})
+	if err != nil {
+		a.log.Errorf("checkTrackedChannels: lnclient.ListChannels: %v", err)
+		return nil
+	}
+	for _, c := range channels.Channels {
+		events = append(events, a.channelOpened(c.ChannelPoint)...)
+	}
+	closed, err := lnclient.ClosedChannels(context.Background(), &lnrpc.ClosedChannelsRequest{})
+	if err != nil {
+		a.log.Errorf("checkTrackedChannels: lnclient.ClosedChannels: %v", err)
+		return events
+	}
+	for _, c := range closed.Channels {
+		events = append(events, a.channelClosed(c.ChannelPoint)...)
+	}
+	return events
+}
@@ -0,0 +1,40 @@
+package account
+
+import (
+	"testing"
+
+	"github.com/breez/breez/data"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+func TestTrackedChannelOps(t *testing.T) {
+	a, api := newDaemonTestService(t)
+	opened := "7b1e6d4ae3d7bb5a8d1b3c1a0b4c2d5e6f708192a3b4c5d6e7f8091a2b3c4d5e:0"
+	closed := "7b1e6d4ae3d7bb5a8d1b3c1a0b4c2d5e6f708192a3b4c5d6e7f8091a2b3c4d5e:1"
+	a.trackOpenAfterRestart(opened, "pubkey")
+	a.trackCloseAfterRestart(closed, "closingtxid")
+
+	// The channel was opened while the daemon was down.
+	api.Lightning.Channels = []*lnrpc.Channel{{Active: true, ChannelPoint: opened}}
+	events := a.completedChannelOps()
+	if len(events) != 1 || events[0].eventType != data.NotificationEvent_CHANNEL_OPENED || events[0].data[0] != opened {
+		t.Fatalf("expected CHANNEL_OPENED for %v, got %v", opened, events)
+	}
+
+	// The close is confirmed after the restart.
+	events = a.trackedChannelEvent(&lnrpc.ChannelEventUpdate{
+		Type: lnrpc.ChannelEventUpdate_CLOSED_CHANNEL,
+		Channel: &lnrpc.ChannelEventUpdate_ClosedChannel{
+			ClosedChannel: &lnrpc.ChannelCloseSummary{ChannelPoint: closed},
+		},
+	})
+	if len(events) != 1 || events[0].eventType != data.NotificationEvent_CHANNEL_CLOSE_CONFIRMED ||
+		events[0].data[1] != "closingtxid" {
+		t.Fatalf("expected CHANNEL_CLOSE_CONFIRMED for %v, got %v", closed, events)
+	}
+
+	// Each operation is notified once.
+	if events := a.completedChannelOps(); len(events) != 0 {
+		t.Fatalf("unexpected events %v", events)
+	}
+}
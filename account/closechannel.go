@@ -0,0 +1,139 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/breez/breez/data"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+/*
+CloseChannel closes the channel with the given txid:index channel point and
+returns the closing txid once it is published. A cooperative close pays
+satPerVbyte, or the medium fee estimate if it is zero, while a force close
+publishes the commitment transaction with its pre-agreed fee. The close is
+followed by CHANNEL_CLOSE_PENDING and then CHANNEL_CLOSE_CONFIRMED, or
+CHANNEL_CLOSE_FAILED, notifications with the channel point.
+*/
+func (a *Service) CloseChannel(channelPoint string, force bool, satPerVbyte int64) (string, error) {
+	chanPoint, err := parseChannelPoint(channelPoint)
+	if err != nil {
+		return "", err
+	}
+	if satPerVbyte < 0 {
+		return "", fmt.Errorf("invalid fee rate %v", satPerVbyte)
+	}
+	if force && satPerVbyte > 0 {
+		return "", errors.New("the fee rate of a force close can't be set")
+	}
+
+	lnclient := a.daemonAPI.APIClient()
+	if lnclient == nil {
+		return "", errors.New("API is not ready")
+	}
+	if !force && satPerVbyte == 0 {
+		estimates, err := a.FeeEstimates()
+		if err != nil {
+			a.log.Errorf("FeeEstimates: %v, letting lnd choose the close fee rate", err)
+		} else {
+			satPerVbyte = estimates.Medium
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := lnclient.CloseChannel(ctx, &lnrpc.CloseChannelRequest{
+		ChannelPoint: chanPoint,
+		Force:        force,
+		SatPerByte:   satPerVbyte,
+	})
+	if err != nil {
+		cancel()
+		return "", err
+	}
+	update, err := stream.Recv()
+	if err != nil {
+		cancel()
+		return "", err
+	}
+	pending := update.GetClosePending()
+	if pending == nil {
+		cancel()
+		return "", fmt.Errorf("unexpected channel close update %v", update)
+	}
+	txid, err := chainhash.NewHash(pending.Txid)
+	if err != nil {
+		cancel()
+		return "", err
+	}
+	closingTxid := txid.String()
+	a.log.Infof("channel %v closing in %v force: %v", channelPoint, closingTxid, force)
+	a.onServiceEvent(data.NotificationEvent{
+		Type: data.NotificationEvent_CHANNEL_CLOSE_PENDING,
+		Data: []string{channelPoint, closingTxid},
+	})
+
+	go func() {
+		select {
+		case <-a.quitChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	go func() {
+		defer cancel()
+		for {
+			update, err := stream.Recv()
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if a.streamEndedByDaemon(err) {
+					a.log.Infof("channel %v close updates stopped: %v, waiting for the daemon", channelPoint, err)
+					a.trackCloseAfterRestart(channelPoint, closingTxid)
+				} else {
+					a.log.Errorf("channel %v close failed: %v", channelPoint, err)
+					a.onServiceEvent(data.NotificationEvent{
+						Type: data.NotificationEvent_CHANNEL_CLOSE_FAILED,
+						Data: []string{channelPoint, err.Error()},
+					})
+				}
+				return
+			}
+			if update.GetChanClose() != nil {
+				a.log.Infof("channel %v close confirmed", channelPoint)
+				a.onServiceEvent(data.NotificationEvent{
+					Type: data.NotificationEvent_CHANNEL_CLOSE_CONFIRMED,
+					Data: []string{channelPoint, closingTxid},
+				})
+				a.syncClosedChannels()
+				return
+			}
+		}
+	}()
+	return closingTxid, nil
+}
+
+func parseChannelPoint(channelPoint string) (*lnrpc.ChannelPoint, error) {
+	s := strings.Split(channelPoint, ":")
+	if len(s) != 2 {
+		return nil, fmt.Errorf("malformed channel point %v", channelPoint)
+	}
+	if len(s[0]) != chainhash.MaxHashStringSize {
+		return nil, fmt.Errorf("malformed channel point %v", channelPoint)
+	}
+	if _, err := chainhash.NewHashFromStr(s[0]); err != nil {
+		return nil, fmt.Errorf("malformed channel point %v: %w", channelPoint, err)
+	}
+	index, err := strconv.ParseUint(s[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("malformed channel point %v: %w", channelPoint, err)
+	}
+	return &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidStr{FundingTxidStr: s[0]},
+		OutputIndex: uint32(index),
+	}, nil
+}
@@ -0,0 +1,22 @@
+package account
+
+import (
+	"testing"
+)
+
+func TestParseChannelPoint(t *testing.T) {
+	txid := "7b1e6d4ae3d7bb5a8d1b3c1a0b4c2d5e6f708192a3b4c5d6e7f8091a2b3c4d5e"
+	chanPoint, err := parseChannelPoint(txid + ":1")
+	if err != nil {
+		t.Fatalf("parseChannelPoint: %v", err)
+	}
+	if chanPoint.GetFundingTxidStr() != txid || chanPoint.OutputIndex != 1 {
+		t.Fatalf("unexpected channel point %v", chanPoint)
+	}
+
+	for _, malformed := range []string{"", txid, txid + ":", txid + ":x", "abc:1", txid + ":1:2"} {
+		if _, err := parseChannelPoint(malformed); err == nil {
+			t.Errorf("parseChannelPoint(%q) succeeded", malformed)
+		}
+	}
+}
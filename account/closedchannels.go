@@ -0,0 +1,91 @@
+package account
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/breez/breez/data"
+	"github.com/golang/protobuf/proto"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// ListClosedChannels returns the closed channels, most recent first, with the
+// reason they were closed.
+func (a *Service) ListClosedChannels() (*data.ClosedChannelsList, error) {
+	channels, err := a.breezDB.FetchClosedChannels()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch closed channels: %w", err)
+	}
+	sort.Slice(channels, func(i, j int) bool {
+		return channels[i].CloseHeight > channels[j].CloseHeight
+	})
+	return &data.ClosedChannelsList{Channels: channels}, nil
+}
+
+// recordClosedChannel stores the closure of the channel, keeping the close
+// timestamp of a channel already recorded.
+func (a *Service) recordClosedChannel(closeSummary *lnrpc.ChannelCloseSummary, sweepTxID string) error {
+	existing, err := a.breezDB.FetchClosedChannel(closeSummary.ChannelPoint)
+	if err != nil {
+		return err
+	}
+	var resolutionTxIDs []string
+	for _, r := range closeSummary.Resolutions {
+		if r.SweepTxid != "" {
+			resolutionTxIDs = append(resolutionTxIDs, r.SweepTxid)
+		}
+	}
+	channel := &data.ClosedChannel{
+		ChannelPoint:      closeSummary.ChannelPoint,
+		RemotePubkey:      closeSummary.RemotePubkey,
+		Capacity:          closeSummary.Capacity,
+		SettledBalance:    closeSummary.SettledBalance,
+		TimeLockedBalance: closeSummary.TimeLockedBalance,
+		CloseType:         closeSummary.CloseType.String(),
+		CloseInitiator:    closeSummary.CloseInitiator.String(),
+		Reason:            closeReason(closeSummary),
+		ClosingTxid:       closeSummary.ClosingTxHash,
+		CloseHeight:       closeSummary.CloseHeight,
+		ResolutionTxids:   resolutionTxIDs,
+		SweepTxid:         sweepTxID,
+	}
+	if existing != nil {
+		channel.CloseTimestamp = existing.CloseTimestamp
+	}
+	if channel.CloseTimestamp == 0 && closeSummary.CloseHeight > 0 {
+		closeTime, err := a.getBlockTime(int64(closeSummary.CloseHeight))
+		if err != nil {
+			return err
+		}
+		channel.CloseTimestamp = closeTime
+	}
+	if existing != nil && proto.Equal(existing, channel) {
+		return nil
+	}
+	return a.breezDB.SaveClosedChannel(channel)
+}
+
+// closeReason explains why the channel was closed and what it means for the
+// funds.
+func closeReason(closeSummary *lnrpc.ChannelCloseSummary) string {
+	switch closeSummary.CloseType {
+	case lnrpc.ChannelCloseSummary_COOPERATIVE_CLOSE:
+		if closeSummary.CloseInitiator == lnrpc.Initiator_INITIATOR_REMOTE {
+			return "The channel was closed by the peer. The balance was returned to the onchain wallet."
+		}
+		return "The channel was closed by this node. The balance was returned to the onchain wallet."
+	case lnrpc.ChannelCloseSummary_LOCAL_FORCE_CLOSE:
+		return "The channel was force closed by this node, usually because the peer was unreachable " +
+			"or a payment expired. The balance is locked onchain for some blocks before it can be swept to the onchain wallet."
+	case lnrpc.ChannelCloseSummary_REMOTE_FORCE_CLOSE:
+		return "The channel was force closed by the peer. The balance was returned to the onchain wallet."
+	case lnrpc.ChannelCloseSummary_BREACH_CLOSE:
+		return "The peer published an old channel state. The channel funds were claimed as a penalty " +
+			"and returned to the onchain wallet."
+	case lnrpc.ChannelCloseSummary_FUNDING_CANCELED:
+		return "The channel funding transaction never confirmed and the channel was canceled."
+	case lnrpc.ChannelCloseSummary_ABANDONED:
+		return "The channel was abandoned by this node."
+	}
+	return "The channel was closed for an unknown reason."
+}
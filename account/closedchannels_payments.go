@@ -47,6 +47,9 @@ func (a *Service) syncClosedChannels() error {
 	}
 
 	for _, c := range closedChannels.Channels {
+		if err := a.recordClosedChannel(c, closingToSweeps[c.ClosingTxHash]); err != nil {
+			a.log.Errorf("failed to record closed channel %v: %v", c.ChannelPoint, err)
+		}
 		if err := a.onClosedChannel(c, closingToSweeps[c.ClosingTxHash]); err != nil {
 			return err
 		}
@@ -165,7 +168,7 @@ func (a *Service) onClosedChannel(closeSummary *lnrpc.ChannelCloseSummary, sweep
 }
 
 func (a *Service) getBlockTime(height int64) (int64, error) {
-	cs, cleanup, err := chainservice.Get(a.cfg.WorkingDir, a.breezDB)
+	cs, cleanup, err := chainservice.GetBlockSource(a.cfg.WorkingDir, a.breezDB)
 	if err != nil {
 		return 0, err
 	}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"net/url"
 	"strings"
 	"time"
@@ -112,9 +111,12 @@ type lnurlLSP struct {
 	//Error fields
 	Status string `json:"status,omitempty"`
 	Reason string `json:"reason,omitempty"`
+
+	// ctx, if set, is used for the callback request.
+	ctx context.Context
 }
 
-func NewLnurlLSP(lnurl string) (*lnurlLSP, error) {
+func (a *Service) newLnurlLSP(lnurl string) (*lnurlLSP, error) {
 	hrp, data, err := decode(lnurl)
 	if err != nil {
 		return nil, err
@@ -126,14 +128,12 @@ func NewLnurlLSP(lnurl string) (*lnurlLSP, error) {
 	if err != nil {
 		return nil, err
 	}
-	res, err := http.Get(string(url))
+	_, body, err := a.lnurlHTTP.get(context.Background(), string(url))
 	if err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
-	decoder := json.NewDecoder(res.Body)
 	var lnurlLSP lnurlLSP
-	err = decoder.Decode(&lnurlLSP)
+	err = json.Unmarshal(body, &lnurlLSP)
 	if err != nil {
 		return nil, err
 	}
@@ -162,17 +162,19 @@ func (lsp *lnurlLSP) OpenChannel(a *Service, pubkey string) error {
 	q.Set("remoteid", pubkey)
 	q.Set("private", "1")
 	u.RawQuery = q.Encode()
-	res, err := http.Get(u.String())
+	ctx := lsp.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	_, body, err := a.lnurlHTTP.get(ctx, u.String())
 	if err != nil {
 		return err
 	}
-	defer res.Body.Close()
-	decoder := json.NewDecoder(res.Body)
 	var r struct {
 		Status string `json:"status"`
 		Reason string `json:"reason"`
 	}
-	err = decoder.Decode(&r)
+	err = json.Unmarshal(body, &r)
 	if err != nil {
 		return err
 	}
@@ -209,7 +211,7 @@ func (a *Service) ConnectLSPPeer(lspID string) error {
 OpenLnurlChannel is responsible for creating a new channel using a lnURL
 */
 func (a *Service) OpenLnurlChannel(lnurl string) error {
-	l, err := NewLnurlLSP(lnurl)
+	l, err := a.newLnurlLSP(lnurl)
 	if err != nil {
 		return err
 	}
@@ -222,15 +224,37 @@ OpenDirectLnurlChannel is responsible for creating a new channel using lnURL
  data
 */
 func (a *Service) OpenDirectLnurlChannel(k1, callback, URI string) error {
+	return a.openDirectLnurlChannel(context.Background(), k1, callback, URI)
+}
+
+func (a *Service) openDirectLnurlChannel(ctx context.Context, k1, callback, URI string) error {
 	l := &lnurlLSP{
 		K1:       k1,
 		CallBack: callback,
 		URI:      URI,
+		ctx:      ctx,
 	}
 	lsp := lsp(l)
 	return a.openChannel(lsp, true)
 }
 
+/*
+FinishLNURLChannel accepts an lnurl-channel offer: it connects to the offering
+node and asks it to open a private channel to us.
+*/
+func (a *Service) FinishLNURLChannel(ctx context.Context, channel *data.LNURLChannel) error {
+	if err := a.openDirectLnurlChannel(ctx, channel.K1, channel.Callback, channel.Uri); err != nil {
+		return err
+	}
+	remotePubkey := strings.Split(channel.Uri, "@")[0]
+	a.log.Infof("lnurl channel requested from %v", remotePubkey)
+	a.onServiceEvent(data.NotificationEvent{
+		Type: data.NotificationEvent_LNURL_CHANNEL_PENDING,
+		Data: []string{remotePubkey},
+	})
+	return nil
+}
+
 func (a *Service) openChannel(l lsp, force bool) error {
 	a.log.Info("openChannel started...")
 	_, err, _ := createChannelGroup.Do("createChannel", func() (interface{}, error) {
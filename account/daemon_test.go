@@ -0,0 +1,45 @@
+package account
+
+import (
+	"io/ioutil"
+	"path"
+	"testing"
+
+	"github.com/breez/breez/config"
+	"github.com/breez/breez/db"
+	"github.com/breez/breez/lnnode/lnnodetest"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btclog"
+)
+
+// newDaemonTestService creates a service backed by an in-memory daemon.
+func newDaemonTestService(t *testing.T) (*Service, *lnnodetest.API) {
+	api, err := lnnodetest.NewAPI()
+	if err != nil {
+		t.Fatalf("failed to create api %v", err)
+	}
+	t.Cleanup(func() { api.Stop() })
+	return &Service{log: btclog.Disabled, daemonAPI: api}, api
+}
+
+// newDBTestService creates a simnet service backed by an in-memory daemon
+// and a breez db in a temporary directory.
+func newDBTestService(t *testing.T) (*Service, *lnnodetest.API) {
+	workingDir := t.TempDir()
+	conf := []byte("[Application Options]\nnetwork=simnet\n")
+	if err := ioutil.WriteFile(path.Join(workingDir, "breez.conf"), conf, 0600); err != nil {
+		t.Fatalf("failed to write config %v", err)
+	}
+	breezDB, cleanup, err := db.Get(workingDir)
+	if err != nil {
+		t.Fatalf("failed to open db %v", err)
+	}
+	t.Cleanup(func() { cleanup() })
+
+	a, api := newDaemonTestService(t)
+	a.cfg = &config.Config{}
+	a.breezDB = breezDB
+	a.activeParams = &chaincfg.SimNetParams
+	a.requestBackup = func() {}
+	return a, api
+}
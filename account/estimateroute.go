@@ -0,0 +1,102 @@
+package account
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/breez/breez/data"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"google.golang.org/grpc/status"
+)
+
+/*
+EstimateRoute finds the route lnd would use to pay amount satoshis to the
+destination and reports its fee and the success probability estimated by
+mission control. If probe is set, an htlc with a random payment hash is sent
+along the route: the destination failing it with unknown payment details means
+the route can carry the amount, while any other failure tells where it broke.
+*/
+func (a *Service) EstimateRoute(destination string, amount int64, probe bool) (*data.EstimateRouteReply, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("invalid amount %v", amount)
+	}
+	if _, err := hex.DecodeString(destination); err != nil {
+		return nil, fmt.Errorf("invalid destination %v: %w", destination, err)
+	}
+	lnclient := a.daemonAPI.APIClient()
+	if lnclient == nil {
+		return nil, errors.New("API is not ready")
+	}
+	if err := a.waitReadyForPayment(); err != nil {
+		return nil, err
+	}
+
+	routes, err := lnclient.QueryRoutes(context.Background(), &lnrpc.QueryRoutesRequest{
+		PubKey:            destination,
+		Amt:               amount,
+		UseMissionControl: true,
+	})
+	if err != nil {
+		errStatus, _ := status.FromError(err)
+		a.log.Infof("EstimateRoute: no route to %v for %v sats: %v", destination, amount, errStatus.Message())
+		return &data.EstimateRouteReply{Failure: errStatus.Message()}, nil
+	}
+	if len(routes.Routes) == 0 {
+		return &data.EstimateRouteReply{
+			Failure: lnrpc.PaymentFailureReason_FAILURE_REASON_NO_ROUTE.String(),
+		}, nil
+	}
+	route := routes.Routes[0]
+	reply := &data.EstimateRouteReply{
+		Routable:           true,
+		FeeMsat:            route.TotalFeesMsat,
+		SuccessProbability: routes.SuccessProb,
+		Hops:               int32(len(route.Hops)),
+		TimeLock:           route.TotalTimeLock,
+	}
+	if !probe {
+		return reply, nil
+	}
+
+	reply.Probed = true
+	failure, err := a.probeRoute(route)
+	if err != nil {
+		return nil, err
+	}
+	if failure != nil && failure.Code != lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS {
+		reply.Routable = false
+		reply.Failure = failure.Code.String()
+		reply.FailureSourceIndex = failure.FailureSourceIndex
+	}
+	return reply, nil
+}
+
+// probeRoute sends an htlc that can't be settled along the route and returns
+// the failure it resolved with.
+func (a *Service) probeRoute(route *lnrpc.Route) (*lnrpc.Failure, error) {
+	routerClient := a.daemonAPI.RouterClient()
+	if routerClient == nil {
+		return nil, errors.New("API is not ready")
+	}
+	hash := make([]byte, 32)
+	if _, err := rand.Read(hash); err != nil {
+		return nil, fmt.Errorf("failed to generate a probe payment hash: %w", err)
+	}
+	attempt, err := routerClient.SendToRouteV2(context.Background(), &routerrpc.SendToRouteRequest{
+		PaymentHash: hash,
+		Route:       route,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to probe the route: %w", err)
+	}
+	if attempt.Status != lnrpc.HTLCAttempt_FAILED {
+		return nil, fmt.Errorf("unexpected probe status %v", attempt.Status)
+	}
+	a.log.Infof("probeRoute: probe %x failed with %v at hop %v", hash,
+		attempt.Failure.GetCode(), attempt.Failure.GetFailureSourceIndex())
+	return attempt.Failure, nil
+}
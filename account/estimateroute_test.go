@@ -0,0 +1,41 @@
+package account
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+func TestEstimateRoute(t *testing.T) {
+	a, api := newDaemonTestService(t)
+	dest := "02c39955c1579afe4824dc0ef4493fdf7f3760b158cf6d367d8570b9f19683afb5"
+
+	reply, err := a.EstimateRoute(dest, 1000, false)
+	if err != nil {
+		t.Fatalf("EstimateRoute: %v", err)
+	}
+	if reply.Routable || reply.Failure == "" {
+		t.Fatalf("expected an unroutable destination, got %v", reply)
+	}
+
+	api.Lightning.Routes = &lnrpc.QueryRoutesResponse{
+		Routes: []*lnrpc.Route{{
+			TotalTimeLock: 700,
+			TotalFeesMsat: 23000,
+			Hops:          []*lnrpc.Hop{{}, {}},
+		}},
+		SuccessProb: 0.8,
+	}
+	reply, err = a.EstimateRoute(dest, 1000, false)
+	if err != nil {
+		t.Fatalf("EstimateRoute: %v", err)
+	}
+	if !reply.Routable || reply.FeeMsat != 23000 || reply.Hops != 2 ||
+		reply.SuccessProbability != 0.8 || reply.Probed {
+		t.Fatalf("unexpected estimate %v", reply)
+	}
+
+	if _, err := a.EstimateRoute("not hex", 1000, false); err == nil {
+		t.Fatalf("expected an invalid destination error")
+	}
+}
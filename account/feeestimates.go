@@ -0,0 +1,103 @@
+package account
+
+import (
+	"sync"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/golang/protobuf/proto"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	lowFeeConfTarget    = 144
+	mediumFeeConfTarget = 6
+	highFeeConfTarget   = 2
+)
+
+// feeTiersCache holds the last fee estimates tiers for ttl.
+type feeTiersCache struct {
+	ttl time.Duration
+
+	mu        sync.Mutex
+	estimates *data.FeeEstimates
+	fetchedAt time.Time
+}
+
+func newFeeTiersCache(ttl time.Duration) *feeTiersCache {
+	if ttl == 0 {
+		ttl = defaultFeeEstimatesCacheTTL
+	}
+	return &feeTiersCache{ttl: ttl}
+}
+
+func satPerVbyte(feePerKw chainfee.SatPerKWeight) int64 {
+	fee := int64(feePerKw.FeePerKVByte() / 1000)
+	if fee < 1 {
+		fee = 1
+	}
+	return fee
+}
+
+/*
+FeeEstimates returns the low, medium and high fee rates, in sat/vbyte, to
+confirm an onchain transaction within a day, an hour and the next blocks.
+They are estimated by the fee estimators used for the sweeps and cached.
+*/
+func (a *Service) FeeEstimates() (*data.FeeEstimates, error) {
+	c := a.feeTiers
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.estimates != nil && time.Since(c.fetchedAt) < c.ttl {
+		return proto.Clone(c.estimates).(*data.FeeEstimates), nil
+	}
+
+	var fees [3]int64
+	for i, target := range []int{lowFeeConfTarget, mediumFeeConfTarget, highFeeConfTarget} {
+		feePerKw, err := a.feeEstimator.EstimateFeePerKw(target)
+		if err != nil {
+			return nil, err
+		}
+		fees[i] = satPerVbyte(feePerKw)
+	}
+	// A longer target never needs a higher fee rate.
+	if fees[1] < fees[0] {
+		fees[1] = fees[0]
+	}
+	if fees[2] < fees[1] {
+		fees[2] = fees[1]
+	}
+	c.estimates = &data.FeeEstimates{Low: fees[0], Medium: fees[1], High: fees[2]}
+	c.fetchedAt = time.Now()
+	return proto.Clone(c.estimates).(*data.FeeEstimates), nil
+}
+
+/*
+FeeRates returns the fee rates, in sat/vbyte, to confirm within each of
+confTargets. The conf targets of the FeeEstimates tiers get the cached tiers
+and the others are estimated by the same fee estimators.
+*/
+func (a *Service) FeeRates(confTargets []int32) (map[int32]int64, error) {
+	estimates, err := a.FeeEstimates()
+	if err != nil {
+		return nil, err
+	}
+	tiers := map[int32]int64{
+		highFeeConfTarget:   estimates.High,
+		mediumFeeConfTarget: estimates.Medium,
+		lowFeeConfTarget:    estimates.Low,
+	}
+	rates := make(map[int32]int64, len(confTargets))
+	for _, target := range confTargets {
+		if rate, ok := tiers[target]; ok {
+			rates[target] = rate
+			continue
+		}
+		feePerKw, err := a.feeEstimator.EstimateFeePerKw(int(target))
+		if err != nil {
+			return nil, err
+		}
+		rates[target] = satPerVbyte(feePerKw)
+	}
+	return rates, nil
+}
@@ -0,0 +1,50 @@
+package account
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFeeEstimates(t *testing.T) {
+	a, api := newDaemonTestService(t)
+	a.feeEstimator = newFeeEstimatorChain(&walletKitFeeEstimator{a.daemonAPI})
+	a.feeTiers = newFeeTiersCache(time.Hour)
+
+	api.WalletKit.FeePerKw = 2500
+	estimates, err := a.FeeEstimates()
+	if err != nil {
+		t.Fatalf("FeeEstimates: %v", err)
+	}
+	if estimates.Low != 10 || estimates.Medium != 10 || estimates.High != 10 {
+		t.Fatalf("unexpected estimates %v", estimates)
+	}
+
+	api.WalletKit.FeePerKw = 5000
+	estimates, err = a.FeeEstimates()
+	if err != nil {
+		t.Fatalf("FeeEstimates: %v", err)
+	}
+	if estimates.Medium != 10 {
+		t.Fatalf("expected the cached estimates, got %v", estimates)
+	}
+
+	a.feeTiers = newFeeTiersCache(time.Hour)
+	api.WalletKit.FeePerKw = 100
+	estimates, err = a.FeeEstimates()
+	if err != nil {
+		t.Fatalf("FeeEstimates: %v", err)
+	}
+	if estimates.Low != 1 || estimates.High != 1 {
+		t.Fatalf("expected the relay fee floor, got %v", estimates)
+	}
+
+	// The sweep targets keep their keys.
+	api.WalletKit.FeePerKw = 5000
+	rates, err := a.FeeRates(sweepConfTargets)
+	if err != nil {
+		t.Fatalf("FeeRates: %v", err)
+	}
+	if len(rates) != 3 || rates[2] != 1 || rates[6] != 1 || rates[25] != 20 {
+		t.Fatalf("unexpected rates %v", rates)
+	}
+}
@@ -0,0 +1,219 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/breez/breez/lnnode"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	defaultFeeEstimatesCacheTTL = 5 * time.Minute
+	feeEstimatesTimeout         = 10 * time.Second
+	maxFeeEstimatesSize         = 64 * 1024
+
+	// maxFeePerKw is the highest fee rate we accept from an estimator
+	// (1000 sat/vbyte), anything above it is considered bogus.
+	maxFeePerKw = chainfee.SatPerKWeight(250_000)
+)
+
+// FeeEstimator estimates the fee rate needed for a transaction to confirm
+// within confTarget blocks.
+type FeeEstimator interface {
+	EstimateFeePerKw(confTarget int) (chainfee.SatPerKWeight, error)
+}
+
+// feeEstimatorChain asks all its estimators and returns the highest estimate
+// that isn't too high, so a transaction confirms in time according to all the
+// estimators that answer. Estimates below the relay fee floor are raised to
+// it.
+type feeEstimatorChain struct {
+	mu         sync.RWMutex
+	estimators []FeeEstimator
+}
+
+func newFeeEstimatorChain(estimators ...FeeEstimator) *feeEstimatorChain {
+	return &feeEstimatorChain{estimators: estimators}
+}
+
+func (c *feeEstimatorChain) add(estimator FeeEstimator) {
+	c.mu.Lock()
+	c.estimators = append(c.estimators, estimator)
+	c.mu.Unlock()
+}
+
+func (c *feeEstimatorChain) set(estimators []FeeEstimator) {
+	c.mu.Lock()
+	c.estimators = estimators
+	c.mu.Unlock()
+}
+
+func (c *feeEstimatorChain) EstimateFeePerKw(confTarget int) (chainfee.SatPerKWeight, error) {
+	c.mu.RLock()
+	estimators := c.estimators
+	c.mu.RUnlock()
+
+	var errs []error
+	var estimate chainfee.SatPerKWeight
+	for _, e := range estimators {
+		feePerKw, err := e.EstimateFeePerKw(confTarget)
+		if err == nil && feePerKw > maxFeePerKw {
+			err = fmt.Errorf("fee rate %v is too high", feePerKw)
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if feePerKw < chainfee.FeePerKwFloor {
+			feePerKw = chainfee.FeePerKwFloor
+		}
+		if feePerKw > estimate {
+			estimate = feePerKw
+		}
+	}
+	if estimate > 0 {
+		return estimate, nil
+	}
+	if len(errs) == 0 {
+		return 0, errors.New("no fee estimator")
+	}
+	return 0, fmt.Errorf("failed to estimate fee for conf target %v: %v", confTarget, errs)
+}
+
+// SetFeeEstimators replaces the estimators used to determine the fee rates
+// of the onchain transactions. The highest sane estimate of them is used.
+func (a *Service) SetFeeEstimators(estimators ...FeeEstimator) {
+	a.feeEstimator.set(estimators)
+}
+
+// walletKitFeeEstimator uses the estimates of the lnd wallet.
+type walletKitFeeEstimator struct {
+	daemonAPI lnnode.API
+}
+
+func (e *walletKitFeeEstimator) EstimateFeePerKw(confTarget int) (chainfee.SatPerKWeight, error) {
+	walletKitClient := e.daemonAPI.WalletKitClient()
+	if walletKitClient == nil {
+		return 0, fmt.Errorf("API not ready")
+	}
+	feeResponse, err := walletKitClient.EstimateFee(context.Background(),
+		&walletrpc.EstimateFeeRequest{ConfTarget: int32(confTarget)})
+	if err != nil {
+		return 0, fmt.Errorf("walletKitClient.EstimateFee(%v): %w", confTarget, err)
+	}
+	return chainfee.SatPerKWeight(feeResponse.SatPerKw), nil
+}
+
+// feeEstimatesURL returns the url of the esplora fee estimates used for
+// network. An empty configured url means the default one and "none"
+// disables the http estimates.
+func feeEstimatesURL(configured string, network *chaincfg.Params) string {
+	if configured == "none" {
+		return ""
+	}
+	if configured != "" {
+		return configured
+	}
+	switch network.Name {
+	case chaincfg.MainNetParams.Name:
+		return "https://mempool.space/api/fee-estimates"
+	case chaincfg.TestNet3Params.Name:
+		return "https://mempool.space/testnet/api/fee-estimates"
+	}
+	return ""
+}
+
+// httpFeeEstimator uses the fee estimates of an esplora compatible http
+// service: a json object mapping conf targets to sat/vbyte fee rates.
+type httpFeeEstimator struct {
+	url      string
+	cacheTTL time.Duration
+	client   *http.Client
+
+	mu        sync.Mutex
+	estimates map[int]float64
+	fetchedAt time.Time
+}
+
+func newHTTPFeeEstimator(url string, cacheTTL time.Duration) *httpFeeEstimator {
+	if cacheTTL == 0 {
+		cacheTTL = defaultFeeEstimatesCacheTTL
+	}
+	return &httpFeeEstimator{
+		url:      url,
+		cacheTTL: cacheTTL,
+		client:   &http.Client{Timeout: feeEstimatesTimeout},
+	}
+}
+
+func (e *httpFeeEstimator) EstimateFeePerKw(confTarget int) (chainfee.SatPerKWeight, error) {
+	estimates, err := e.feeEstimates()
+	if err != nil {
+		return 0, err
+	}
+
+	// Use the estimate of the largest target not above confTarget, or the
+	// smallest target if there is none.
+	targets := make([]int, 0, len(estimates))
+	for target := range estimates {
+		targets = append(targets, target)
+	}
+	if len(targets) == 0 {
+		return 0, fmt.Errorf("no fee estimates in %v", e.url)
+	}
+	sort.Ints(targets)
+	target := targets[0]
+	for _, t := range targets {
+		if t <= confTarget {
+			target = t
+		}
+	}
+	satPerKVByte := chainfee.SatPerKVByte(estimates[target] * 1000)
+	return satPerKVByte.FeePerKWeight(), nil
+}
+
+func (e *httpFeeEstimator) feeEstimates() (map[int]float64, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.estimates != nil && time.Since(e.fetchedAt) < e.cacheTTL {
+		return e.estimates, nil
+	}
+
+	resp, err := e.client.Get(e.url)
+	if err != nil {
+		return nil, fmt.Errorf("http.Get(%v): %w", e.url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("http.Get(%v): status %v", e.url, resp.StatusCode)
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(nil, resp.Body, maxFeeEstimatesSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %v: %w", e.url, err)
+	}
+	var raw map[string]float64
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal(%s): %w", body, err)
+	}
+	estimates := make(map[int]float64, len(raw))
+	for k, v := range raw {
+		target, err := strconv.Atoi(k)
+		if err != nil || target <= 0 {
+			continue
+		}
+		estimates[target] = v
+	}
+	e.estimates, e.fetchedAt = estimates, time.Now()
+	return estimates, nil
+}
@@ -0,0 +1,72 @@
+package account
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+type failingFeeEstimator struct{}
+
+func (failingFeeEstimator) EstimateFeePerKw(confTarget int) (chainfee.SatPerKWeight, error) {
+	return 0, errors.New("not ready")
+}
+
+type fixedFeeEstimator chainfee.SatPerKWeight
+
+func (f fixedFeeEstimator) EstimateFeePerKw(confTarget int) (chainfee.SatPerKWeight, error) {
+	return chainfee.SatPerKWeight(f), nil
+}
+
+func TestFeeEstimatorChain(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"1": 20.5, "3": 10, "6": 4, "144": 1}`))
+	}))
+	defer server.Close()
+
+	chain := newFeeEstimatorChain(failingFeeEstimator{}, fixedFeeEstimator(maxFeePerKw+1))
+	chain.add(newHTTPFeeEstimator(server.URL, 0))
+
+	tests := []struct {
+		confTarget  int
+		satPerVbyte float64
+	}{
+		{1, 20.5},
+		{2, 20.5},
+		{6, 4},
+		{25, 4},
+		{1008, 1},
+	}
+	for _, test := range tests {
+		feePerKw, err := chain.EstimateFeePerKw(test.confTarget)
+		if err != nil {
+			t.Fatalf("EstimateFeePerKw(%v): %v", test.confTarget, err)
+		}
+		expected := chainfee.SatPerKVByte(test.satPerVbyte * 1000).FeePerKWeight()
+		if expected < chainfee.FeePerKwFloor {
+			expected = chainfee.FeePerKwFloor
+		}
+		if feePerKw != expected {
+			t.Fatalf("EstimateFeePerKw(%v) = %v, expected %v", test.confTarget, feePerKw, expected)
+		}
+	}
+	if requests != 1 {
+		t.Fatalf("expected the estimates to be fetched once, got %v requests", requests)
+	}
+
+	// The highest of the estimates is used.
+	chain.set([]FeeEstimator{fixedFeeEstimator(3000), failingFeeEstimator{}, fixedFeeEstimator(5000)})
+	if feePerKw, err := chain.EstimateFeePerKw(6); err != nil || feePerKw != 5000 {
+		t.Fatalf("EstimateFeePerKw(6) = %v, %v expected 5000", feePerKw, err)
+	}
+
+	chain.set([]FeeEstimator{failingFeeEstimator{}, fixedFeeEstimator(maxFeePerKw + 1)})
+	if _, err := chain.EstimateFeePerKw(6); err == nil {
+		t.Fatal("expected an error when all the estimates are too high")
+	}
+}
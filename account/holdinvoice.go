@@ -0,0 +1,173 @@
+package account
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/breez/breez/data"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+/*
+AddHoldInvoice creates an invoice for the given payment hash that is held once
+paid until it is settled with the preimage by SettleHoldInvoice or canceled by
+CancelHoldInvoice. Since the preimage isn't known to us the invoice can't be
+used to open a new channel, so the amount must fit in the existing channels.
+*/
+func (a *Service) AddHoldInvoice(request *data.AddHoldInvoiceRequest) (string, error) {
+	hash, err := lntypes.MakeHashFromStr(request.PaymentHash)
+	if err != nil {
+		return "", fmt.Errorf("invalid payment hash: %w", err)
+	}
+	invoicesClient := a.daemonAPI.InvoicesClient()
+	if invoicesClient == nil {
+		return "", errors.New("API is not ready")
+	}
+	lspInfo := request.LspInfo
+	if lspInfo == nil {
+		return "", errors.New("missing LSP information")
+	}
+
+	invoice := request.InvoiceDetails
+	if invoice == nil {
+		return "", errors.New("missing invoice details")
+	}
+	if invoice.Expiry <= 0 {
+		invoice.Expiry = defaultInvoiceExpiry
+	}
+	maxReceive, err := a.getMaxReceiveSingleChannel()
+	if err != nil {
+		return "", err
+	}
+	if maxReceive < invoice.Amount {
+		return "", fmt.Errorf("the amount exceeds the maximum receivable amount of %v sats", maxReceive)
+	}
+	if err := a.waitReadyForPayment(); err != nil {
+		return "", err
+	}
+	routingHints, err := a.getLSPRoutingHints(lspInfo, request.RouteHints, invoice.Amount)
+	if err != nil {
+		return "", fmt.Errorf("failed to get LSP routing hints %w", err)
+	}
+	if len(routingHints) == 0 {
+		return "", errors.New("no routing information")
+	}
+
+	response, err := invoicesClient.AddHoldInvoice(context.Background(), &invoicesrpc.AddHoldInvoiceRequest{
+		Memo:       formatTextMemo(invoice),
+		Hash:       hash[:],
+		ValueMsat:  invoice.Amount * 1000,
+		Expiry:     invoice.Expiry,
+		RouteHints: routingHints,
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := a.breezDB.AddHoldInvoiceHash(hash[:], []byte(response.PaymentRequest)); err != nil {
+		return "", fmt.Errorf("failed to add hold invoice %w", err)
+	}
+	if err := a.trackHoldInvoice(hash[:]); err != nil {
+		return "", err
+	}
+	a.log.Infof("Generated hold invoice: %v", response.PaymentRequest)
+	return response.PaymentRequest, nil
+}
+
+// SettleHoldInvoice settles an accepted hold invoice with the given hex
+// encoded preimage.
+func (a *Service) SettleHoldInvoice(preimage string) error {
+	p, err := lntypes.MakePreimageFromStr(preimage)
+	if err != nil {
+		return fmt.Errorf("invalid preimage: %w", err)
+	}
+	invoicesClient := a.daemonAPI.InvoicesClient()
+	if invoicesClient == nil {
+		return errors.New("API is not ready")
+	}
+	_, err = invoicesClient.SettleInvoice(context.Background(), &invoicesrpc.SettleInvoiceMsg{
+		Preimage: p[:],
+	})
+	return err
+}
+
+// CancelHoldInvoice cancels the hold invoice with the given hex encoded
+// payment hash, failing back its htlcs if it was already accepted.
+func (a *Service) CancelHoldInvoice(paymentHash string) error {
+	hash, err := lntypes.MakeHashFromStr(paymentHash)
+	if err != nil {
+		return fmt.Errorf("invalid payment hash: %w", err)
+	}
+	invoicesClient := a.daemonAPI.InvoicesClient()
+	if invoicesClient == nil {
+		return errors.New("API is not ready")
+	}
+	_, err = invoicesClient.CancelInvoice(context.Background(), &invoicesrpc.CancelInvoiceMsg{
+		PaymentHash: hash[:],
+	})
+	return err
+}
+
+func (a *Service) trackHoldInvoices() error {
+	hashes, err := a.breezDB.FetchHoldInvoiceHashes()
+	if err != nil {
+		return fmt.Errorf("trackHoldInvoices: failed to fetch hold invoice hashes %w", err)
+	}
+	for _, hash := range hashes {
+		if err := a.trackHoldInvoice(hash); err != nil {
+			a.log.Errorf("trackHoldInvoices: %v", err)
+		}
+	}
+	return nil
+}
+
+// trackHoldInvoice notifies when the hold invoice is accepted or canceled and
+// stops tracking it once it is resolved.
+func (a *Service) trackHoldInvoice(hash []byte) error {
+	a.log.Infof("subscribing hold invoice %x", hash)
+	invoicesClient := a.daemonAPI.InvoicesClient()
+	if invoicesClient == nil {
+		return errors.New("trackHoldInvoice: api not ready")
+	}
+	stream, err := invoicesClient.SubscribeSingleInvoice(context.Background(), &invoicesrpc.SubscribeSingleInvoiceRequest{
+		RHash: hash,
+	})
+	if err != nil {
+		return fmt.Errorf("trackHoldInvoice: failed to subscribe hold invoice %w", err)
+	}
+
+	go func() {
+		for {
+			invoice, err := stream.Recv()
+			if err != nil {
+				a.log.Errorf("trackHoldInvoice: failed to receive an invoice : %v", err)
+				return
+			}
+			a.log.Infof("trackHoldInvoice: invoice %x state %v", hash, invoice.State)
+			switch invoice.State {
+			case lnrpc.Invoice_ACCEPTED:
+				a.onServiceEvent(data.NotificationEvent{
+					Type: data.NotificationEvent_HOLD_INVOICE_ACCEPTED,
+					Data: []string{hex.EncodeToString(hash), strconv.FormatInt(invoice.AmtPaidSat, 10)},
+				})
+			case lnrpc.Invoice_CANCELED:
+				a.onServiceEvent(data.NotificationEvent{
+					Type: data.NotificationEvent_HOLD_INVOICE_CANCELED,
+					Data: []string{hex.EncodeToString(hash)},
+				})
+				fallthrough
+			case lnrpc.Invoice_SETTLED:
+				// Settled invoices are added to the payments by watchPayments.
+				if err := a.breezDB.RemoveHoldInvoiceHash(hash); err != nil {
+					a.log.Errorf("trackHoldInvoice: failed to remove hold invoice %x: %v", hash, err)
+				}
+				return
+			}
+		}
+	}()
+	return nil
+}
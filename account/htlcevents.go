@@ -0,0 +1,51 @@
+package account
+
+import (
+	"strconv"
+
+	"github.com/breez/breez/data"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+)
+
+// onHtlcEvent notifies an HTLC_EVENT with the data:
+// event type (SEND, RECEIVE or FORWARD), kind (forward, forward_fail, settle
+// or link_fail), incoming channel id, outgoing channel id, incoming htlc id,
+// outgoing htlc id, timestamp in nanoseconds and the failure, if any.
+func (a *Service) onHtlcEvent(event *routerrpc.HtlcEvent) {
+	var kind, failure string
+	switch e := event.Event.(type) {
+	case *routerrpc.HtlcEvent_ForwardEvent:
+		kind = "forward"
+	case *routerrpc.HtlcEvent_ForwardFailEvent:
+		kind = "forward_fail"
+	case *routerrpc.HtlcEvent_SettleEvent:
+		kind = "settle"
+	case *routerrpc.HtlcEvent_LinkFailEvent:
+		kind = "link_fail"
+		failure = e.LinkFailEvent.WireFailure.String()
+		if e.LinkFailEvent.FailureDetail != routerrpc.FailureDetail_UNKNOWN {
+			failure += ": " + e.LinkFailEvent.FailureDetail.String()
+		}
+		if e.LinkFailEvent.FailureString != "" {
+			failure += ": " + e.LinkFailEvent.FailureString
+		}
+	default:
+		return
+	}
+	a.log.Debugf("htlc event %v %v %v:%v -> %v:%v %v", event.EventType, kind,
+		event.IncomingChannelId, event.IncomingHtlcId,
+		event.OutgoingChannelId, event.OutgoingHtlcId, failure)
+	a.onServiceEvent(data.NotificationEvent{
+		Type: data.NotificationEvent_HTLC_EVENT,
+		Data: []string{
+			event.EventType.String(),
+			kind,
+			strconv.FormatUint(event.IncomingChannelId, 10),
+			strconv.FormatUint(event.OutgoingChannelId, 10),
+			strconv.FormatUint(event.IncomingHtlcId, 10),
+			strconv.FormatUint(event.OutgoingHtlcId, 10),
+			strconv.FormatUint(event.TimestampNs, 10),
+			failure,
+		},
+	})
+}
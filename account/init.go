@@ -1,6 +1,7 @@
 package account
 
 import (
+	"context"
 	"fmt"
 	"sync"
 
@@ -17,11 +18,6 @@ import (
 
 // Service is the account service that controls all aspects of routing node connection
 // and user channels as an abstracted account.
-type LnurlPayMetadata struct {
-	encoded string
-	data    [][]string
-}
-
 type Service struct {
 	started            int32
 	stopped            int32
@@ -37,8 +33,30 @@ type Service struct {
 	onServiceEvent     func(data.NotificationEvent)
 	requestBackup      func()
 
-	lnurlWithdrawing   string
-	lnurlPayMetadata LnurlPayMetadata
+	lnurlHTTP              *lnurlHTTPClient
+	lnurlCache             *lnurlParamsCache
+	retryingLNURLWithdraws int32
+	lnurlCtxMu             sync.Mutex
+	lnurlCtx               context.Context
+	lnurlCancel            context.CancelFunc
+
+	feeEstimator *feeEstimatorChain
+	feeTiers     *feeTiersCache
+
+	paymentStats paymentStats
+
+	paymentInterceptor        PaymentInterceptor
+	paymentInterceptThreshold int64
+
+	watchMu      sync.Mutex
+	watchCancels map[string]context.CancelFunc
+
+	craftedSweepsMu sync.Mutex
+	craftedSweeps   map[string]*data.SweepPackage
+
+	channelOpsMu   sync.Mutex
+	orphanedOpens  map[string]string
+	orphanedCloses map[string]string
 
 	activeParams     *chaincfg.Params
 	lspReadyPayment    func() (bool, error)
@@ -78,7 +96,14 @@ func NewService(
 		return nil, fmt.Errorf("unknown network type: %v", cfg.Network)
 	}
 
-	return &Service{
+	lnurlHTTP, err := newLNURLHTTPClient(cfg.LNURLCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	lnurlCtx, lnurlCancel := context.WithCancel(context.Background())
+
+	a := &Service{
 		cfg:             cfg,
 		log:             logger,
 		daemonAPI:       daemonAPI,
@@ -89,5 +114,16 @@ func NewService(
 		activeParams:    activeParams,
 		requestBackup:   requestBackup,
 		lspReadyPayment: lspReadyPayment,
-	}, nil
+		lnurlHTTP:       lnurlHTTP,
+		lnurlCache:      newLNURLParamsCache(cfg.LNURLCfg.CacheTTL),
+		lnurlCtx:        lnurlCtx,
+		lnurlCancel:     lnurlCancel,
+		watchCancels:    make(map[string]context.CancelFunc),
+	}
+	a.feeEstimator = newFeeEstimatorChain(&walletKitFeeEstimator{a.daemonAPI})
+	a.feeTiers = newFeeTiersCache(cfg.FeeCfg.EstimatesCacheTTL)
+	if url := feeEstimatesURL(cfg.FeeCfg.EstimatesURL, activeParams); url != "" {
+		a.feeEstimator.add(newHTTPFeeEstimator(url, cfg.FeeCfg.EstimatesCacheTTL))
+	}
+	return a, nil
 }
@@ -0,0 +1,72 @@
+package account
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+)
+
+// PaymentInterceptor approves outgoing payments. Returning an error rejects
+// the payment before it is sent. maxFeeSat is -1 if the fee isn't limited.
+type PaymentInterceptor interface {
+	InterceptPayment(amountSat int64, destination string, maxFeeSat int64) error
+}
+
+// PaymentInterceptorFunc is an adapter to use an ordinary function as a
+// PaymentInterceptor.
+type PaymentInterceptorFunc func(amountSat int64, destination string, maxFeeSat int64) error
+
+// InterceptPayment calls f(amountSat, destination, maxFeeSat).
+func (f PaymentInterceptorFunc) InterceptPayment(amountSat int64, destination string, maxFeeSat int64) error {
+	return f(amountSat, destination, maxFeeSat)
+}
+
+// SetPaymentInterceptor sets the interceptor that has to approve every
+// outgoing payment above thresholdSat. A nil interceptor approves all the
+// payments.
+func (a *Service) SetPaymentInterceptor(interceptor PaymentInterceptor, thresholdSat int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.paymentInterceptor = interceptor
+	a.paymentInterceptThreshold = thresholdSat
+}
+
+// interceptPayment asks the payment interceptor to approve the payment if
+// its amount is above the threshold.
+func (a *Service) interceptPayment(payReq *lnrpc.PayReq, sendRequest *routerrpc.SendPaymentRequest) error {
+	a.mu.Lock()
+	interceptor, threshold := a.paymentInterceptor, a.paymentInterceptThreshold
+	a.mu.Unlock()
+	if interceptor == nil {
+		return nil
+	}
+
+	amountMsat := sendRequest.Amt*1000 + sendRequest.AmtMsat
+	destination := hex.EncodeToString(sendRequest.Dest)
+	if payReq != nil {
+		if amountMsat == 0 {
+			amountMsat = payReq.NumMsat
+		}
+		destination = payReq.Destination
+	}
+	amountSat := amountMsat / 1000
+	if amountSat <= threshold {
+		return nil
+	}
+
+	maxFeeSat := int64(-1)
+	switch {
+	case sendRequest.FeeLimitSat > 0 && sendRequest.FeeLimitSat != math.MaxInt64:
+		maxFeeSat = sendRequest.FeeLimitSat
+	case sendRequest.FeeLimitMsat > 0 && sendRequest.FeeLimitMsat != math.MaxInt64:
+		maxFeeSat = sendRequest.FeeLimitMsat / 1000
+	}
+	if err := interceptor.InterceptPayment(amountSat, destination, maxFeeSat); err != nil {
+		a.log.Infof("payment of %v sats to %v rejected by the interceptor: %v", amountSat, destination, err)
+		return fmt.Errorf("payment rejected: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package account
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/btcsuite/btclog"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+)
+
+func TestInterceptPayment(t *testing.T) {
+	a := &Service{log: btclog.Disabled}
+	var intercepted []int64
+	a.SetPaymentInterceptor(PaymentInterceptorFunc(func(amountSat int64, destination string, maxFeeSat int64) error {
+		intercepted = append(intercepted, amountSat, maxFeeSat)
+		if destination != "dest" {
+			t.Fatalf("unexpected destination %v", destination)
+		}
+		if amountSat > 5000 {
+			return errors.New("over the spending limit")
+		}
+		return nil
+	}), 1000)
+
+	payReq := &lnrpc.PayReq{Destination: "dest", NumMsat: 2000000}
+	if err := a.interceptPayment(payReq, &routerrpc.SendPaymentRequest{Amt: 500, FeeLimitSat: 10}); err != nil {
+		t.Fatalf("payment below the threshold rejected: %v", err)
+	}
+	if err := a.interceptPayment(payReq, &routerrpc.SendPaymentRequest{FeeLimitSat: math.MaxInt64}); err != nil {
+		t.Fatalf("payment rejected: %v", err)
+	}
+	if err := a.interceptPayment(payReq, &routerrpc.SendPaymentRequest{Amt: 6000, FeeLimitMsat: 30000}); err == nil {
+		t.Fatalf("expected the payment to be rejected")
+	}
+	expected := []int64{2000, -1, 6000, 30}
+	if len(intercepted) != len(expected) {
+		t.Fatalf("expected intercepted %v, got %v", expected, intercepted)
+	}
+	for i := range expected {
+		if intercepted[i] != expected[i] {
+			t.Fatalf("expected intercepted %v, got %v", expected, intercepted)
+		}
+	}
+}
@@ -1,9 +1,11 @@
 package account
 
 import (
-	"crypto/hmac"
+	"bytes"
+	"context"
 	"crypto/rand"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
@@ -11,35 +13,224 @@ import (
 	"fmt"
 	"io"
 	"math"
-	"net/http"
 	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/zpay32"
-	"github.com/tyler-smith/go-bip32"
 
 	"github.com/breez/breez/data"
 
 	"github.com/fiatjaf/go-lnurl"
 )
 
+// lightningAddressRegexp matches a LUD-16 internet identifier (user@domain).
+var lightningAddressRegexp = regexp.MustCompile(
+	`^([a-z0-9\-_.+]+)@([a-z0-9\-]+(?:\.[a-z0-9\-]+)+(?::[0-9]+)?)$`)
+
+const (
+	lnurlVerifyAttempts = 5
+	lnurlVerifyInterval = 3 * time.Second
+
+	// lnurlRouteMaxFeePPM is the maximum fee, in parts per million of the
+	// payment amount, a route returned by an lnurl-pay service may charge.
+	lnurlRouteMaxFeePPM = 10000
+	// lnurlRouteMinFeeMsat is the fee a route may always charge, regardless
+	// of the payment amount.
+	lnurlRouteMinFeeMsat = 10000
+)
+
+// lud17Schemes maps the LUD-17 url schemes to the lnurl kind they indicate.
+var lud17Schemes = map[string]string{
+	"lnurlp":  "lnurl-pay",
+	"lnurlw":  "lnurl-withdraw",
+	"lnurlc":  "lnurl-channel",
+	"keyauth": "lnurl-auth",
+}
+
 type LoginResponse struct {
 	lnurl.LNURLResponse
 	Token string `json:"token"`
 }
 
-func (a *Service) HandleLNURL(rawString string) (*data.LNUrlResponse, error) {
-	encodedLnurl, ok := lnurl.FindLNURLInText(rawString)
+// lnurlPayResponse2 extends the lnurl-pay callback response with the
+// LUD-21 verify url.
+type lnurlPayResponse2 struct {
+	lnurl.LNURLPayResponse2
+	Verify string `json:"verify"`
+}
+
+// lnurlVerifyResponse is the response of a LUD-21 verify url.
+type lnurlVerifyResponse struct {
+	lnurl.LNURLResponse
+	Settled  bool   `json:"settled"`
+	Preimage string `json:"preimage"`
+	PR       string `json:"pr"`
+}
+
+func isBIP21(rawString string) bool {
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(rawString)), "bitcoin:")
+}
+
+// handleBIP21 parses a BIP21 uri. If it has a lightning parameter holding an
+// lnurl, the lnurl is resolved as well.
+// Ref. https://github.com/bitcoin/bips/blob/master/bip-0021.mediawiki
+func (a *Service) handleBIP21(ctx context.Context, rawString string) (*data.LNUrlResponse, error) {
+	s := strings.TrimSpace(rawString)[len("bitcoin:"):]
+	address, rawQuery := s, ""
+	if i := strings.Index(s, "?"); i >= 0 {
+		address, rawQuery = s[:i], s[i+1:]
+	}
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bitcoin uri: %w", err)
+	}
+
+	info := &data.BIP21Info{
+		Address: address,
+		Label:   query.Get("label"),
+		Message: query.Get("message"),
+	}
+	if amount := query.Get("amount"); amount != "" {
+		btc, err := strconv.ParseFloat(amount, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount %v: %w", amount, err)
+		}
+		amt, err := btcutil.NewAmount(btc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount %v: %w", amount, err)
+		}
+		info.Amount = int64(amt)
+	}
+	if address != "" {
+		if _, err := btcutil.DecodeAddress(address, a.activeParams); err != nil {
+			return nil, fmt.Errorf("invalid address %v: %w", address, err)
+		}
+	}
+
+	if lightning := query.Get("lightning"); lightning != "" {
+		if _, err := zpay32.Decode(lightning, a.activeParams); err == nil {
+			info.Bolt11 = lightning
+		} else {
+			lnurlResponse, err := a.HandleLNURL(ctx, lightning)
+			if err != nil {
+				return nil, err
+			}
+			info.Lnurl = lnurlResponse
+		}
+	}
+
+	return &data.LNUrlResponse{
+		Action: &data.LNUrlResponse_Bip21{Bip21: info},
+	}, nil
+}
+
+// lnurlAuthAction returns the optional action parameter of an lnurl-auth url,
+// or an empty string if it is missing or unknown.
+func lnurlAuthAction(callback string) string {
+	u, err := url.Parse(callback)
+	if err != nil {
+		return ""
+	}
+	switch action := u.Query().Get("action"); action {
+	case "register", "login", "link", "auth":
+		return action
+	default:
+		return ""
+	}
+}
+
+// parseLUD17 converts the url schemes defined in LUD-17 to the https (or http
+// for onion services) url they stand for, and returns the lnurl kind the
+// scheme indicates.
+// Ref. https://github.com/fiatjaf/lnurl-rfc/blob/luds/17.md
+func parseLUD17(rawString string) (string, string, bool) {
+	s := strings.TrimSpace(rawString)
+	if strings.HasPrefix(strings.ToLower(s), "lightning:") {
+		s = s[len("lightning:"):]
+	}
+	i := strings.Index(s, "://")
+	if i < 0 {
+		return "", "", false
+	}
+	kind, ok := lud17Schemes[strings.ToLower(s[:i])]
 	if !ok {
-		return nil, fmt.Errorf("'%s' does not contain an LNURL.", rawString)
+		return "", "", false
+	}
+	u, err := url.Parse("https" + s[i:])
+	if err != nil {
+		return "", "", false
+	}
+	if strings.HasSuffix(u.Hostname(), ".onion") {
+		u.Scheme = "http"
+	}
+	return u.String(), kind, true
+}
+
+// parseLightningAddress returns the normalized lightning address and the
+// well-known lnurlp url it resolves to.
+// Ref. https://github.com/fiatjaf/lnurl-rfc/blob/luds/16.md
+func parseLightningAddress(rawString string) (string, string, bool) {
+	address := strings.ToLower(strings.TrimSpace(rawString))
+	address = strings.TrimPrefix(address, "lightning:")
+	match := lightningAddressRegexp.FindStringSubmatch(address)
+	if match == nil {
+		return "", "", false
+	}
+	user, domain := match[1], match[2]
+
+	scheme := "https"
+	if strings.HasSuffix(strings.Split(domain, ":")[0], ".onion") {
+		scheme = "http"
+	}
+	return address, fmt.Sprintf("%v://%v/.well-known/lnurlp/%v", scheme, domain, user), true
+}
+
+func (a *Service) HandleLNURL(ctx context.Context, rawString string) (*data.LNUrlResponse, error) {
+	if isBIP21(rawString) {
+		return a.handleBIP21(ctx, rawString)
+	}
+
+	var lightningAddress, expectedKind string
+	lud17URL, kind, isLUD17 := parseLUD17(rawString)
+	encodedLnurl, ok := lnurl.FindLNURLInText(rawString)
+	if isLUD17 {
+		encoded, err := lnurl.LNURLEncode(lud17URL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %v: %w", lud17URL, err)
+		}
+		expectedKind = kind
+		encodedLnurl = encoded
+	} else if !ok {
+		address, addressURL, isAddress := parseLightningAddress(rawString)
+		if !isAddress {
+			return nil, fmt.Errorf("'%s' does not contain an LNURL.", rawString)
+		}
+		encoded, err := lnurl.LNURLEncode(addressURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode lightning address %v: %w", address, err)
+		}
+		lightningAddress = address
+		encodedLnurl = encoded
 	}
 
 	a.log.Infof("HandleLNURL %v", encodedLnurl)
-	rawurl, iparams, err := lnurl.HandleLNURL(encodedLnurl)
+	rawurl, iparams, err := a.fetchLNURLParams(ctx, encodedLnurl)
 	if err != nil {
 		return nil, err
 	}
+	if expectedKind != "" && iparams.LNURLKind() != expectedKind {
+		return nil, fmt.Errorf("expected %v but got %v", expectedKind, iparams.LNURLKind())
+	}
 
 	switch params := iparams.(type) {
 	case lnurl.LNURLAuthParams:
@@ -50,15 +241,12 @@ func (a *Service) HandleLNURL(rawString string) (*data.LNUrlResponse, error) {
 					Callback: params.Callback,
 					K1:       params.K1,
 					Host:     params.Host,
+					Action:   lnurlAuthAction(params.Callback),
 				},
 			},
 		}, nil
 	case lnurl.LNURLWithdrawResponse:
-		qs := params.CallbackURL.Query()
-		qs.Set("k1", params.K1)
-		params.CallbackURL.RawQuery = qs.Encode()
-		a.lnurlWithdrawing = params.CallbackURL.String()
-		a.log.Infof("lnurl response: %v", a.lnurlWithdrawing)
+		a.log.Infof("lnurl response: %v", params.Callback)
 		return &data.LNUrlResponse{
 			Action: &data.LNUrlResponse_Withdraw{
 				&data.LNUrlWithdraw{
@@ -69,6 +257,8 @@ func (a *Service) HandleLNURL(rawString string) (*data.LNUrlResponse, error) {
 						float64(params.MaxWithdrawable) / 1000,
 					)),
 					DefaultDescription: params.DefaultDescription,
+					Callback:           params.Callback,
+					K1:                 params.K1,
 				},
 			},
 		}, nil
@@ -88,24 +278,21 @@ func (a *Service) HandleLNURL(rawString string) (*data.LNUrlResponse, error) {
 		if url, err := url.Parse(rawurl); err == nil {
 			host = url.Host
 		}
-		a.lnurlPayMetadata.encoded = params.EncodedMetadata
-		a.lnurlPayMetadata.data = params.Metadata
-		var metadata []*data.LNUrlPayMetadata
-		for _, e := range params.Metadata {
-			metadata = append(metadata,
-				&data.LNUrlPayMetadata{
-					Entry: []string{e[0], e[1]},
-				})
-		}
+		metadata := lnurlPayMetadataEntries(params.Metadata)
 
 		return &data.LNUrlResponse{
 			Action: &data.LNUrlResponse_PayResponse1{
 				&data.LNURLPayResponse1{
-					Host:      host,
-					Callback:  params.Callback,
-					MinAmount: int64(math.Floor(float64(params.MinSendable) / 1000)),
-					MaxAmount: int64(math.Floor(float64(params.MaxSendable) / 1000)),
-					Metadata:  metadata,
+					Host:             host,
+					Callback:         params.Callback,
+					MinAmount:        int64(math.Floor(float64(params.MinSendable) / 1000)),
+					MaxAmount:        int64(math.Floor(float64(params.MaxSendable) / 1000)),
+					Metadata:         metadata,
+					LightningAddress: lightningAddress,
+					EncodedMetadata:  params.EncodedMetadata,
+					CommentAllowed:   params.CommentAllowed,
+					Description:      params.Metadata.Description(),
+					LongDescription:  lnurlPayLongDescription(params.Metadata),
 				},
 			},
 		}, nil
@@ -115,31 +302,25 @@ func (a *Service) HandleLNURL(rawString string) (*data.LNUrlResponse, error) {
 }
 
 // FinishLNURLAuth logs in using lnurl auth protocol
-func (a *Service) FinishLNURLAuth(authParams *data.LNURLAuth) (string, error) {
+func (a *Service) FinishLNURLAuth(ctx context.Context, authParams *data.LNURLAuth) (string, error) {
 
-	key, err := a.getLNURLAuthKey()
-	if err != nil {
-		return "", err
+	// A valid token from a previous login can be used without signing again.
+	if authParams.Jwt {
+		token, err := a.GetLNURLAuthToken(authParams.Host)
+		if err != nil {
+			a.log.Errorf("failed to fetch lnurl auth token for %v: %v", authParams.Host, err)
+		}
+		if token != "" {
+			return token, nil
+		}
 	}
 
-	// hash host using master key
-	h := hmac.New(sha256.New, key.Key)
-	if _, err := h.Write([]byte(authParams.Host)); err != nil {
+	linkingPrivKey, err := a.lnurlAuthLinkingKey(authParams.Host)
+	if err != nil {
 		return "", err
 	}
-	sha := h.Sum(nil)
+	linkingPubKey := linkingPrivKey.PubKey()
 
-	// create 4 elements derivation path using hashed value.
-	first16 := sha[:16]
-	for i := 0; i < 4; i++ {
-		nextChildIndex := binary.BigEndian.Uint32(first16[i*4 : i*4+4])
-		for key, err = key.NewChildKey(nextChildIndex); err != nil; {
-			nextChildIndex++
-		}
-	}
-
-	// this is the result keypair.
-	linkingPrivKey, linkingPubKey := btcec.PrivKeyFromBytes(btcec.S256(), key.Key)
 	k1Decoded, err := hex.DecodeString(authParams.K1)
 	if err != nil {
 		return "", fmt.Errorf("failed to decode k1 challenge %w", err)
@@ -170,14 +351,14 @@ func (a *Service) FinishLNURLAuth(authParams *data.LNURLAuth) (string, error) {
 		query.Add("jwt", "true")
 	}
 	url.RawQuery = query.Encode()
-	resp, err := http.Get(url.String())
+	_, body, err := a.lnurlHTTP.get(ctx, url.String())
 	if err != nil {
 		return "", err
 	}
 
 	// check response
 	var lnurlresp LoginResponse
-	err = json.NewDecoder(resp.Body).Decode(&lnurlresp)
+	err = json.Unmarshal(body, &lnurlresp)
 	if err != nil {
 		return "", err
 	}
@@ -186,19 +367,44 @@ func (a *Service) FinishLNURLAuth(authParams *data.LNURLAuth) (string, error) {
 		return "", errors.New(lnurlresp.Reason)
 	}
 
+	if err := a.saveLNURLAuthIdentity(authParams.Host, linkingPubKey, lnurlresp.Token); err != nil {
+		a.log.Errorf("failed to save lnurl auth identity for %v: %v", authParams.Host, err)
+	}
 	return lnurlresp.Token, nil
 }
 
-func (a *Service) FinishLNURLWithdraw(bolt11 string) error {
-	callback := a.lnurlWithdrawing
+// FinishLNURLWithdraw sends the invoice to the callback of the withdraw
+// request returned by HandleLNURL. If the service can't be reached the
+// callback is queued and ErrLNURLWithdrawQueued is returned.
+func (a *Service) FinishLNURLWithdraw(ctx context.Context, withdraw *data.LNUrlWithdraw, bolt11 string) error {
+	err := a.sendLNURLWithdraw(ctx, withdraw, bolt11)
+	if !isConnectivityError(err) {
+		return err
+	}
+	a.log.Infof("FinishLNURLWithdraw: queueing callback: %v", err)
+	if err := a.queueLNURLWithdraw(withdraw, bolt11); err != nil {
+		return err
+	}
+	return ErrLNURLWithdrawQueued
+}
+
+func (a *Service) sendLNURLWithdraw(ctx context.Context, withdraw *data.LNUrlWithdraw, bolt11 string) error {
+	callback, err := url.Parse(withdraw.Callback)
+	if err != nil {
+		return fmt.Errorf("invalid callback url %v", err)
+	}
+	query := callback.Query()
+	query.Set("k1", withdraw.K1)
+	query.Set("pr", bolt11)
+	callback.RawQuery = query.Encode()
 
-	resp, err := http.Get(callback + "&pr=" + bolt11)
+	_, body, err := a.lnurlHTTP.get(ctx, callback.String())
 	if err != nil {
 		return err
 	}
 
 	var lnurlresp lnurl.LNURLResponse
-	err = json.NewDecoder(resp.Body).Decode(&lnurlresp)
+	err = json.Unmarshal(body, &lnurlresp)
 	if err != nil {
 		return err
 	}
@@ -207,32 +413,53 @@ func (a *Service) FinishLNURLWithdraw(bolt11 string) error {
 		return errors.New(lnurlresp.Reason)
 	}
 
+	a.lnurlCache.removeWithdraw(withdraw.K1)
 	return nil
 }
 
-func (a *Service) getLNURLAuthKey() (*bip32.Key, error) {
-	needsBackup := false
-	key, err := a.breezDB.FetchLNURLAuthKey(func() ([]byte, error) {
-		needsBackup = true
-		return bip32.NewSeed()
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch lnurl key %w", err)
+// WithdrawLNURL creates an invoice for amount and sends it to the callback of
+// the withdraw request returned by HandleLNURL.
+func (a *Service) WithdrawLNURL(ctx context.Context, request *data.WithdrawLNURLRequest) (*data.WithdrawLNURLReply, error) {
+	withdraw := request.Withdraw
+	if withdraw == nil {
+		return nil, errors.New("missing withdraw request")
+	}
+	if request.Amount < withdraw.MinAmount || request.Amount > withdraw.MaxAmount {
+		return nil, fmt.Errorf("Amount must be between %v and %v.", withdraw.MinAmount, withdraw.MaxAmount)
 	}
-	if needsBackup {
-		a.requestBackup()
+	description := request.Description
+	if description == "" {
+		description = withdraw.DefaultDescription
 	}
 
-	// Create master private key from seed
-	masterKey, err := bip32.NewMasterKey(key)
+	payReq, lspFee, err := a.AddInvoice(&data.AddInvoiceRequest{
+		InvoiceDetails: &data.InvoiceMemo{
+			Description: description,
+			Amount:      request.Amount,
+		},
+		LspInfo: request.LspInfo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	invoice, err := zpay32.Decode(payReq, a.activeParams)
 	if err != nil {
-		return nil, fmt.Errorf("error creating lnurl master key: %w", err)
+		return nil, err
 	}
 
-	return masterKey, nil
+	err = a.FinishLNURLWithdraw(ctx, withdraw, payReq)
+	if err != nil && err != ErrLNURLWithdrawQueued {
+		return nil, err
+	}
+	return &data.WithdrawLNURLReply{
+		PaymentHash:    hex.EncodeToString(invoice.PaymentHash[:]),
+		PaymentRequest: payReq,
+		LspFee:         lspFee,
+		Queued:         err == ErrLNURLWithdrawQueued,
+	}, nil
 }
 
-func (a *Service) FinishLNURLPay(params *data.LNURLPayResponse1) (*data.LNUrlPayInfo, error) {
+func (a *Service) FinishLNURLPay(ctx context.Context, params *data.LNURLPayResponse1) (*data.LNUrlPayInfo, error) {
 
 	// Ref. https://github.com/fiatjaf/lnurl-rfc/blob/master/lnurl-pay.md
 	// TODO Check for response elements that might be null before using them.
@@ -263,20 +490,27 @@ func (a *Service) FinishLNURLPay(params *data.LNURLPayResponse1) (*data.LNUrlPay
 	}
 	query.Add("nonce", hex.EncodeToString(nonce))
 
-	/* TODO FINDOUT Should we populate the FromNodes optional parameter?
-	   If yes, then how?
-	   if params.FromNodes != "" {
-	       query.Add("fromnodes", params.FromNodes)
-	   }
-	*/
+	fromNodes := params.FromNodes
+	if fromNodes == "" && a.cfg.LNURLCfg.PayFromNodes {
+		if fromNodes, err = a.lnurlPayFromNodes(); err != nil {
+			a.log.Infof("FinishLNURLPay: failed to get fromnodes: %v", err)
+		}
+	}
+	if fromNodes != "" {
+		query.Add("fromnodes", fromNodes)
+	}
 
+	// LUD-12: the comment length must not exceed commentAllowed.
 	if params.Comment != "" {
+		if n := utf8.RuneCountInString(params.Comment); int64(n) > params.CommentAllowed {
+			return nil, fmt.Errorf("Comment is too long: %v characters, %v allowed.", n, params.CommentAllowed)
+		}
 		query.Add("comment", params.Comment)
 	}
 
 	url.RawQuery = query.Encode()
 	a.log.Infof("FinishLNURLPay: request.url: %v", url)
-	resp, err := http.Get(url.String())
+	resp, body, err := a.lnurlHTTP.get(ctx, url.String())
 	if err != nil {
 		return nil, err
 	}
@@ -348,8 +582,8 @@ func (a *Service) FinishLNURLPay(params *data.LNURLPayResponse1) (*data.LNUrlPay
 
 	*/
 
-	var payResponse2 lnurl.LNURLPayResponse2
-	if err = json.NewDecoder(resp.Body).Decode(&payResponse2); err != nil {
+	var payResponse2 lnurlPayResponse2
+	if err = json.Unmarshal(body, &payResponse2); err != nil {
 		return nil, err
 	}
 
@@ -359,6 +593,13 @@ func (a *Service) FinishLNURLPay(params *data.LNURLPayResponse1) (*data.LNUrlPay
 
 	a.log.Infof("FinishLNURLPay: payResponse2 %+v", payResponse2)
 
+	// A null disposable flag should be interpreted as true.
+	if payResponse2.Disposable != nil && !*payResponse2.Disposable {
+		if err := a.saveLNURLPayLink(params); err != nil {
+			a.log.Errorf("FinishLNURLPay: failed to save pay link: %v", err)
+		}
+	}
+
 	// 7. `LN WALLET` Verifies that `h` tag in provided invoice is a hash of `metadata` string converted to byte array in UTF-8 encoding.
 	invoice, err := zpay32.Decode(payResponse2.PR, a.activeParams)
 	if err != nil {
@@ -369,7 +610,7 @@ func (a *Service) FinishLNURLPay(params *data.LNURLPayResponse1) (*data.LNUrlPay
 		return nil, errors.New("Description hash not found in invoice.")
 	}
 
-	if sum := sha256.Sum256([]byte(a.lnurlPayMetadata.encoded)); hex.EncodeToString(sum[:]) != hex.EncodeToString((*invoice.DescriptionHash)[:]) {
+	if sum := sha256.Sum256([]byte(params.EncodedMetadata)); hex.EncodeToString(sum[:]) != hex.EncodeToString((*invoice.DescriptionHash)[:]) {
 		err = errors.New("Invoice description hash does not match metadata.")
 		a.log.Error(fmt.Sprintf("FinishLNURLPay: %v", err))
 		return nil, err
@@ -384,16 +625,18 @@ func (a *Service) FinishLNURLPay(params *data.LNURLPayResponse1) (*data.LNUrlPay
 		return nil, errors.New("Invoice amount does not match the amount set by user.")
 	}
 
-	/* TODO 9. If routes array is not empty: verifies signature for every provided `ChannelUpdate`, may use these routes if fee levels are acceptable.
+	/* 9. If routes array is not empty: verifies signature for every provided `ChannelUpdate`, may use these routes if fee levels are acceptable.
 
 	   ref. https://github.com/lightningnetwork/lightning-rfc/blob/master/07-routing-gossip.md#the-channel_update-message
-	   ref. github.com\lightningnetwork\lnd\lnwire\channel_update.go
-
-	   ref. github.com/lightningnetwork/lnd/lnwire/channel_update.go
-	   ref. github.com/lightningnetwork/lnd/lnwire/message.go
 	*/
-	if len(payResponse2.Routes) > 0 {
-		a.log.Info("FinishLNURLPay: response has routes.")
+	var routeHints []*data.LNUrlPayRouteHint
+	for _, route := range payResponse2.Routes {
+		hint, err := lnurlPayRouteHint(route, params.Amount)
+		if err != nil {
+			a.log.Infof("FinishLNURLPay: ignoring route: %v", err)
+			continue
+		}
+		routeHints = append(routeHints, hint)
 	}
 
 	// 10. If `successAction` is not null: `LN WALLET` makes sure that `tag` value of is of supported type, aborts a payment otherwise.
@@ -420,21 +663,214 @@ func (a *Service) FinishLNURLPay(params *data.LNURLPayResponse1) (*data.LNUrlPay
 
 	}
 
+	var metadata lnurl.Metadata
+	if err = json.Unmarshal([]byte(params.EncodedMetadata), &metadata); err != nil {
+		return nil, fmt.Errorf("invalid lnurl-pay metadata: %w", err)
+	}
+
 	// 11. `LN WALLET` pays the invoice, no additional user confirmation is required at this point.
 	info := &data.LNUrlPayInfo{
 		PaymentHash:        hex.EncodeToString(invoice.PaymentHash[:]),
 		SuccessAction:      _sa,
 		Comment:            params.Comment,
-		InvoiceDescription: lnurl.Metadata(a.lnurlPayMetadata.data).Description(),
+		InvoiceDescription: metadata.Description(),
+		LongDescription:    lnurlPayLongDescription(metadata),
 		Host:               params.Host,
 		Metadata:           params.Metadata,
 		Invoice:            payResponse2.PR,
+		LightningAddress:   params.LightningAddress,
+		VerifyUrl:          payResponse2.Verify,
+		RouteHints:         routeHints,
+		Timestamp:          time.Now().Unix(),
 	}
 	a.breezDB.SaveLNUrlPayInfo(info)
 	return info, nil
 
 }
 
+// FinishLNURLPayAndSend runs FinishLNURLPay and pays the returned invoice,
+// limiting the routing fee to maxFeeSat (no limit if zero). The aes success
+// action, if any, is decrypted using the payment preimage.
+func (a *Service) FinishLNURLPayAndSend(ctx context.Context, params *data.LNURLPayResponse1, maxFeeSat int64) (*data.LNURLPayResult, error) {
+	info, err := a.FinishLNURLPay(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	feeLimitSat := maxFeeSat
+	if feeLimitSat <= 0 {
+		feeLimitSat = math.MaxInt64
+	}
+	payment, _, err := a.sendPaymentForRequestWithFeeLimit(info.Invoice, 0, nil, feeLimitSat)
+	if err != nil {
+		return nil, err
+	}
+
+	// The message is usually decrypted already when the payment is synced.
+	if updated, err := a.breezDB.FetchLNUrlPayInfo(info.PaymentHash); err == nil && updated != nil {
+		info = updated
+	}
+	if info.SuccessAction != nil && info.SuccessAction.Tag == "aes" && info.SuccessAction.Message == "" {
+		info.SuccessAction.Message = a.decryptLNUrlPayMessageAndNotify(info.PaymentHash, payment.PaymentPreimage)
+	}
+
+	return &data.LNURLPayResult{
+		Info:     info,
+		Preimage: payment.PaymentPreimage,
+		FeeSat:   payment.FeeSat,
+	}, nil
+}
+
+// ListLNUrlPayInfos returns the lnurl-pay payments saved by FinishLNURLPay,
+// newest first. If host is not empty only payments to host are returned.
+// A zero limit returns all the payments after offset.
+func (a *Service) ListLNUrlPayInfos(host string, offset, limit int32) (*data.LNUrlPayInfoList, error) {
+	infos, err := a.breezDB.FetchAllLNUrlPayInfos()
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []*data.LNUrlPayInfo
+	for _, info := range infos {
+		if host == "" || info.Host == host {
+			filtered = append(filtered, info)
+		}
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].Timestamp > filtered[j].Timestamp
+	})
+
+	if offset < 0 {
+		offset = 0
+	}
+	if int(offset) >= len(filtered) {
+		return &data.LNUrlPayInfoList{}, nil
+	}
+	filtered = filtered[offset:]
+	if limit > 0 && int(limit) < len(filtered) {
+		filtered = filtered[:limit]
+	}
+	return &data.LNUrlPayInfoList{InfoList: filtered}, nil
+}
+
+// ListSavedPayLinks returns the reusable lnurl-pay links saved by FinishLNURLPay.
+func (a *Service) ListSavedPayLinks() (*data.LNURLPayLinks, error) {
+	links, err := a.breezDB.FetchLNUrlPayLinks()
+	if err != nil {
+		return nil, err
+	}
+	return &data.LNURLPayLinks{Links: links}, nil
+}
+
+// DeleteSavedPayLink deletes the saved lnurl-pay link with the given callback.
+func (a *Service) DeleteSavedPayLink(callback string) error {
+	return a.breezDB.DeleteLNUrlPayLink(callback)
+}
+
+func (a *Service) saveLNURLPayLink(params *data.LNURLPayResponse1) error {
+	return a.breezDB.SaveLNUrlPayLink(&data.LNURLPayResponse1{
+		Callback:         params.Callback,
+		MinAmount:        params.MinAmount,
+		MaxAmount:        params.MaxAmount,
+		Metadata:         params.Metadata,
+		Tag:              params.Tag,
+		Host:             params.Host,
+		LightningAddress: params.LightningAddress,
+		EncodedMetadata:  params.EncodedMetadata,
+		CommentAllowed:   params.CommentAllowed,
+	})
+}
+
+// VerifyLNURLPayment queries the LUD-21 verify url of an lnurl-pay payment
+// and stores the settlement status reported by the service.
+// Ref. https://github.com/lnurl/luds/blob/luds/21.md
+func (a *Service) VerifyLNURLPayment(ctx context.Context, paymentHash string) (*data.LNUrlPayInfo, error) {
+	info, err := a.breezDB.FetchLNUrlPayInfo(paymentHash)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get LNUrl-Pay info from database: %w", err)
+	}
+	if info == nil {
+		return nil, fmt.Errorf("lnurl-pay info for paymentHash %v not found", paymentHash)
+	}
+	if info.VerifyUrl == "" {
+		return nil, errors.New("lnurl-pay service does not support payment verification")
+	}
+	if info.Settled {
+		return info, nil
+	}
+
+	_, body, err := a.lnurlHTTP.get(ctx, info.VerifyUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var verifyResponse lnurlVerifyResponse
+	if err = json.Unmarshal(body, &verifyResponse); err != nil {
+		return nil, err
+	}
+	if verifyResponse.Status == "ERROR" {
+		return nil, errors.New(verifyResponse.Reason)
+	}
+	if !verifyResponse.Settled {
+		return info, nil
+	}
+
+	preimage, err := hex.DecodeString(verifyResponse.Preimage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode preimage %w", err)
+	}
+	if hash := sha256.Sum256(preimage); hex.EncodeToString(hash[:]) != paymentHash {
+		return nil, errors.New("preimage returned by lnurl-pay service does not match the payment hash")
+	}
+
+	info.Settled = true
+	info.Preimage = verifyResponse.Preimage
+	if err = a.breezDB.UpdateLNUrlPayInfo(info); err != nil {
+		return nil, err
+	}
+	a.log.Infof("VerifyLNURLPayment: payment %v settled", paymentHash)
+	return info, nil
+}
+
+// pollLNURLPayVerification checks the verify url of a sent lnurl-pay payment
+// until the service reports it as settled.
+func (a *Service) pollLNURLPayVerification(paymentHash string) {
+	for i := 0; i < lnurlVerifyAttempts; i++ {
+		info, err := a.VerifyLNURLPayment(context.Background(), paymentHash)
+		if err != nil {
+			a.log.Infof("pollLNURLPayVerification: %v", err)
+		} else if info.Settled {
+			return
+		}
+
+		select {
+		case <-time.After(lnurlVerifyInterval):
+		case <-a.quitChan:
+			return
+		}
+	}
+}
+
+// decryptLNUrlPayMessageAndNotify decrypts the aes success action of a settled
+// lnurl-pay payment and notifies the app about the decrypted message.
+func (a *Service) decryptLNUrlPayMessageAndNotify(paymentHash, preimageHex string) string {
+	preimage, err := hex.DecodeString(preimageHex)
+	if err != nil || len(preimage) == 0 {
+		a.log.Errorf("decryptLNUrlPayMessage: invalid preimage for %v", paymentHash)
+		return ""
+	}
+	message, err := a.DecryptLNUrlPayMessage(paymentHash, preimage)
+	if err != nil {
+		a.log.Errorf("decryptLNUrlPayMessage: Could not decrypt 'aes' lnurl-pay message: %s", err)
+		return ""
+	}
+	a.onServiceEvent(data.NotificationEvent{
+		Type: data.NotificationEvent_LNURL_PAY_MESSAGE_DECRYPTED,
+		Data: []string{paymentHash, message},
+	})
+	return message
+}
+
 func (a *Service) DecryptLNUrlPayMessage(paymentHash string, preimage []byte) (string, error) {
 
 	info, err := a.breezDB.FetchLNUrlPayInfo(paymentHash)
@@ -460,7 +896,7 @@ func (a *Service) DecryptLNUrlPayMessage(paymentHash string, preimage []byte) (s
 			return "", fmt.Errorf("Could not decrypt message: %v", err)
 		}
 
-		if err = a.breezDB.SaveLNUrlPayInfo(info); err != nil {
+		if err = a.breezDB.UpdateLNUrlPayInfo(info); err != nil {
 			return "", fmt.Errorf("Could not save deciphered message: %s", err)
 		}
 
@@ -470,3 +906,155 @@ func (a *Service) DecryptLNUrlPayMessage(paymentHash string, preimage []byte) (s
 
 	return "", errors.New("DecryptLNUrlPayMessage: could not find lnUrlPayInfo with given paymentHash.")
 }
+
+// lnurlPayMetadataEntries converts the lnurl-pay metadata to its data
+// representation. Base64 encoded images are decoded so the image bytes and
+// mime type are passed instead of the encoded string.
+func lnurlPayMetadataEntries(metadata lnurl.Metadata) []*data.LNUrlPayMetadata {
+	var entries []*data.LNUrlPayMetadata
+	for _, e := range metadata {
+		if len(e) < 2 {
+			continue
+		}
+		entry := &data.LNUrlPayMetadata{Entry: []string{e[0], e[1]}}
+		if mimeType := strings.TrimSuffix(e[0], ";base64"); strings.HasPrefix(e[0], "image/") && mimeType != e[0] {
+			if image, err := base64.StdEncoding.DecodeString(e[1]); err == nil {
+				entry = &data.LNUrlPayMetadata{
+					Entry:    []string{e[0]},
+					Image:    image,
+					MimeType: mimeType,
+				}
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// lnurlPayLongDescription returns the text/long-desc metadata entry, which
+// holds the full details of what is being paid for.
+func lnurlPayLongDescription(metadata lnurl.Metadata) string {
+	return metadata.Entry("text/long-desc")
+}
+
+// lnurlPayFromNodes returns the comma separated pubkeys of the peers of our
+// active channels, or our own pubkey if there are none, so the lnurl-pay
+// service can return routes that start from our topology.
+func (a *Service) lnurlPayFromNodes() (string, error) {
+	lnclient := a.daemonAPI.APIClient()
+	if lnclient == nil {
+		return "", errors.New("daemon is not ready")
+	}
+	channels, err := lnclient.ListChannels(context.Background(), &lnrpc.ListChannelsRequest{
+		ActiveOnly: true,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var nodes []string
+	seen := make(map[string]struct{})
+	for _, c := range channels.Channels {
+		if _, ok := seen[c.RemotePubkey]; ok {
+			continue
+		}
+		seen[c.RemotePubkey] = struct{}{}
+		nodes = append(nodes, c.RemotePubkey)
+	}
+	if len(nodes) == 0 {
+		nodes = append(nodes, a.daemonAPI.NodePubkey())
+	}
+	return strings.Join(nodes, ","), nil
+}
+
+// lnurlPayRouteHint verifies the channel updates of a route returned by an
+// lnurl-pay service and converts it to a route hint if its fees are acceptable.
+func lnurlPayRouteHint(route []lnurl.RouteInfo, amountMsat uint64) (*data.LNUrlPayRouteHint, error) {
+	if len(route) == 0 {
+		return nil, errors.New("empty route")
+	}
+
+	hint := &data.LNUrlPayRouteHint{}
+	var feeMsat uint64
+	for _, hop := range route {
+		nodeID, err := hex.DecodeString(hop.NodeId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node id %v: %w", hop.NodeId, err)
+		}
+		pubKey, err := btcec.ParsePubKey(nodeID, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("invalid node id %v: %w", hop.NodeId, err)
+		}
+		update, err := decodeChannelUpdate(hop.ChannelUpdate)
+		if err != nil {
+			return nil, err
+		}
+		if err := routing.VerifyChannelUpdateSignature(update, pubKey); err != nil {
+			return nil, err
+		}
+		if update.ChannelFlags&lnwire.ChanUpdateDisabled != 0 {
+			return nil, fmt.Errorf("channel %v is disabled", update.ShortChannelID)
+		}
+
+		feeMsat += uint64(update.BaseFee) +
+			amountMsat*uint64(update.FeeRate)/1000000
+		hint.HopHints = append(hint.HopHints, &data.LNUrlPayHopHint{
+			NodeId:                    hop.NodeId,
+			ChanId:                    update.ShortChannelID.ToUint64(),
+			FeeBaseMsat:               update.BaseFee,
+			FeeProportionalMillionths: update.FeeRate,
+			CltvExpiryDelta:           uint32(update.TimeLockDelta),
+		})
+	}
+
+	maxFeeMsat := amountMsat * lnurlRouteMaxFeePPM / 1000000
+	if maxFeeMsat < lnurlRouteMinFeeMsat {
+		maxFeeMsat = lnurlRouteMinFeeMsat
+	}
+	if feeMsat > maxFeeMsat {
+		return nil, fmt.Errorf("route fee %v msat exceeds %v msat", feeMsat, maxFeeMsat)
+	}
+	return hint, nil
+}
+
+// decodeChannelUpdate decodes a hex encoded channel_update gossip message,
+// with or without its message type prefix.
+func decodeChannelUpdate(hexUpdate string) (*lnwire.ChannelUpdate, error) {
+	b, err := hex.DecodeString(hexUpdate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid channel update: %w", err)
+	}
+	if len(b) >= 2 && lnwire.MessageType(binary.BigEndian.Uint16(b)) == lnwire.MsgChannelUpdate {
+		msg, err := lnwire.ReadMessage(bytes.NewReader(b), 0)
+		if err == nil {
+			if update, ok := msg.(*lnwire.ChannelUpdate); ok {
+				return update, nil
+			}
+		}
+	}
+	update := &lnwire.ChannelUpdate{}
+	if err := update.Decode(bytes.NewReader(b), 0); err != nil {
+		return nil, fmt.Errorf("invalid channel update: %w", err)
+	}
+	return update, nil
+}
+
+// lnurlPayRouteHints converts the route hints stored for an lnurl-pay payment
+// to the route hints used by the router.
+func lnurlPayRouteHints(info *data.LNUrlPayInfo) []*lnrpc.RouteHint {
+	var routeHints []*lnrpc.RouteHint
+	for _, h := range info.RouteHints {
+		routeHint := &lnrpc.RouteHint{}
+		for _, hop := range h.HopHints {
+			routeHint.HopHints = append(routeHint.HopHints, &lnrpc.HopHint{
+				NodeId:                    hop.NodeId,
+				ChanId:                    hop.ChanId,
+				FeeBaseMsat:               hop.FeeBaseMsat,
+				FeeProportionalMillionths: hop.FeeProportionalMillionths,
+				CltvExpiryDelta:           hop.CltvExpiryDelta,
+			})
+		}
+		routeHints = append(routeHints, routeHint)
+	}
+	return routeHints
+}
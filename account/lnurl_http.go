@@ -0,0 +1,301 @@
+package account
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/breez/breez/config"
+	"github.com/fiatjaf/go-lnurl"
+	"github.com/tidwall/gjson"
+)
+
+const (
+	defaultLNURLTimeout         = 30 * time.Second
+	defaultLNURLRetries         = 2
+	defaultLNURLMaxResponseSize = 1024 * 1024
+	lnurlRetryDelay             = time.Second
+	maxLNURLRedirects           = 5
+)
+
+var (
+	// ErrLNURLResponseTooLarge is returned when an lnurl service responds with
+	// a body larger than the configured maximum response size.
+	ErrLNURLResponseTooLarge = errors.New("lnurl response is too large")
+
+	// ErrLNURLTooManyRedirects is returned when an lnurl service redirects
+	// more than maxLNURLRedirects times.
+	ErrLNURLTooManyRedirects = errors.New("lnurl request redirected too many times")
+)
+
+// lnurlHTTPClient is the http client shared by all the lnurl flows.
+type lnurlHTTPClient struct {
+	client          *http.Client
+	transport       *http.Transport
+	retries         int
+	maxResponseSize int64
+
+	mu       sync.RWMutex
+	proxyURL *url.URL
+	policy   LNURLPolicy
+}
+
+func newLNURLHTTPClient(cfg config.LNURLConfig) (*lnurlHTTPClient, error) {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultLNURLTimeout
+	}
+	retries := cfg.Retries
+	if retries < 0 {
+		retries = 0
+	} else if retries == 0 {
+		retries = defaultLNURLRetries
+	}
+	maxResponseSize := cfg.MaxResponseSize
+	if maxResponseSize <= 0 {
+		maxResponseSize = defaultLNURLMaxResponseSize
+	}
+
+	transport := &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout:   timeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSHandshakeTimeout:   timeout,
+		ResponseHeaderTimeout: timeout,
+		IdleConnTimeout:       90 * time.Second,
+		MaxIdleConns:          10,
+	}
+	c := &lnurlHTTPClient{
+		transport:       transport,
+		retries:         retries,
+		maxResponseSize: maxResponseSize,
+	}
+	c.client = &http.Client{
+		Transport:     transport,
+		Timeout:       timeout,
+		CheckRedirect: c.checkRedirect,
+	}
+	transport.Proxy = c.proxy
+	transport.TLSClientConfig = &tls.Config{VerifyConnection: c.verifyConnection}
+	if err := c.setProxy(cfg.Proxy); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// setProxy routes all the lnurl requests through the given proxy url.
+// socks5://127.0.0.1:9050 can be used to send the requests over Tor.
+// An empty url disables the proxy.
+func (c *lnurlHTTPClient) setProxy(proxy string) error {
+	var proxyURL *url.URL
+	if proxy != "" {
+		var err error
+		proxyURL, err = url.Parse(proxy)
+		if err != nil {
+			return fmt.Errorf("invalid lnurl proxy %v: %w", proxy, err)
+		}
+		switch proxyURL.Scheme {
+		case "socks5", "socks5h", "http", "https":
+		default:
+			return fmt.Errorf("unsupported lnurl proxy scheme %v", proxyURL.Scheme)
+		}
+	}
+
+	c.mu.Lock()
+	c.proxyURL = proxyURL
+	c.mu.Unlock()
+	c.transport.CloseIdleConnections()
+	return nil
+}
+
+func (c *lnurlHTTPClient) proxy(req *http.Request) (*url.URL, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.proxyURL, nil
+}
+
+// checkURL makes sure .onion urls are only requested through a proxy and
+// that the policy allows the request.
+func (c *lnurlHTTPClient) checkURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if strings.HasSuffix(u.Hostname(), ".onion") {
+		c.mu.RLock()
+		hasProxy := c.proxyURL != nil
+		c.mu.RUnlock()
+		if !hasProxy {
+			return fmt.Errorf("a tor proxy is needed to reach %v", u.Hostname())
+		}
+	}
+	return c.allowRequest(u)
+}
+
+// get sends a GET request to rawURL and returns the response together with
+// its body. Requests that fail before a response is received are retried.
+func (c *lnurlHTTPClient) get(ctx context.Context, rawURL string) (*http.Response, []byte, error) {
+	err := c.checkURL(rawURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	for attempt := 0; attempt <= c.retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(lnurlRetryDelay * time.Duration(attempt)):
+			case <-ctx.Done():
+				return nil, nil, ctx.Err()
+			}
+		}
+		var req *http.Request
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		var resp *http.Response
+		resp, err = c.client.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, nil, ctx.Err()
+			}
+			var urlErr *url.Error
+			if errors.As(err, &urlErr) &&
+				(errors.Is(err, ErrLNURLTooManyRedirects) || errors.Is(err, ErrLNURLRequestDenied)) {
+				return nil, nil, urlErr.Err
+			}
+			continue
+		}
+		body, err := c.readBody(resp)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp, body, nil
+	}
+	return nil, nil, err
+}
+
+// readBody reads at most maxResponseSize bytes of the response body so a
+// rogue service can't exhaust the memory of the app.
+func (c *lnurlHTTPClient) readBody(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+	if resp.ContentLength > c.maxResponseSize {
+		return nil, fmt.Errorf("%w: %v bytes", ErrLNURLResponseTooLarge, resp.ContentLength)
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, c.maxResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > c.maxResponseSize {
+		return nil, fmt.Errorf("%w: more than %v bytes", ErrLNURLResponseTooLarge, c.maxResponseSize)
+	}
+	return body, nil
+}
+
+func (c *lnurlHTTPClient) checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) > maxLNURLRedirects {
+		return ErrLNURLTooManyRedirects
+	}
+	return c.allowRequest(req.URL)
+}
+
+// fetchLNURLParams resolves an lnurl the same way lnurl.HandleLNURL does but
+// using the lnurl http client. Pay and withdraw params are served from the
+// cache when the same lnurl was resolved recently.
+func (a *Service) fetchLNURLParams(ctx context.Context, rawlnurl string) (string, lnurl.LNURLParams, error) {
+	encoded, ok := lnurl.FindLNURLInText(rawlnurl)
+	if !ok {
+		return "", nil, errors.New("invalid bech32-encoded lnurl: " + rawlnurl)
+	}
+	encoded = strings.ToLower(encoded)
+	if rawurl, params, ok := a.lnurlCache.get(encoded); ok {
+		return rawurl, params, nil
+	}
+	rawurl, params, err := a.requestLNURLParams(ctx, encoded)
+	if err != nil {
+		return rawurl, nil, err
+	}
+	a.lnurlCache.put(encoded, rawurl, params)
+	return rawurl, params, nil
+}
+
+func (a *Service) requestLNURLParams(ctx context.Context, encoded string) (string, lnurl.LNURLParams, error) {
+	rawurl, err := lnurl.LNURLDecode(encoded)
+	if err != nil {
+		return "", nil, err
+	}
+
+	parsed, err := url.Parse(rawurl)
+	if err != nil {
+		return rawurl, nil, err
+	}
+
+	query := parsed.Query()
+	switch query.Get("tag") {
+	case "login":
+		value, err := lnurl.HandleAuth(rawurl, parsed, query)
+		return rawurl, value, err
+	case "withdrawRequest":
+		if value, ok := lnurl.HandleFastWithdraw(query); ok {
+			return rawurl, value, nil
+		}
+	}
+
+	_, body, err := a.lnurlHTTP.get(ctx, rawurl)
+	if err != nil {
+		return rawurl, nil, err
+	}
+
+	j := gjson.ParseBytes(body)
+	if j.Get("status").String() == "ERROR" {
+		return rawurl, nil, lnurl.LNURLErrorResponse{
+			URL:    parsed,
+			Reason: j.Get("reason").String(),
+			Status: "ERROR",
+		}
+	}
+
+	switch j.Get("tag").String() {
+	case "withdrawRequest":
+		value, err := lnurl.HandleWithdraw(j)
+		return rawurl, value, err
+	case "payRequest":
+		value, err := lnurl.HandlePay(j)
+		return rawurl, value, err
+	case "channelRequest":
+		value, err := lnurl.HandleChannel(j)
+		return rawurl, value, err
+	default:
+		return rawurl, nil, errors.New("unknown response tag " + j.String())
+	}
+}
+
+// SetLNURLProxy sets the proxy used for all the lnurl requests.
+func (a *Service) SetLNURLProxy(proxy string) error {
+	return a.lnurlHTTP.setProxy(proxy)
+}
+
+// NewLNURLContext returns a context for a single lnurl flow. All the contexts
+// returned are canceled by CancelLNURLRequests.
+func (a *Service) NewLNURLContext() (context.Context, context.CancelFunc) {
+	a.lnurlCtxMu.Lock()
+	defer a.lnurlCtxMu.Unlock()
+	return context.WithCancel(a.lnurlCtx)
+}
+
+// CancelLNURLRequests aborts all the in-flight lnurl requests.
+func (a *Service) CancelLNURLRequests() {
+	a.lnurlCtxMu.Lock()
+	defer a.lnurlCtxMu.Unlock()
+	a.lnurlCancel()
+	a.lnurlCtx, a.lnurlCancel = context.WithCancel(context.Background())
+}
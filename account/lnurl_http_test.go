@@ -0,0 +1,78 @@
+package account
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/breez/breez/config"
+)
+
+func TestLNURLHTTPLimits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/large":
+			w.Write([]byte(strings.Repeat("a", 2048)))
+		case "/loop":
+			http.Redirect(w, r, "/loop", http.StatusFound)
+		default:
+			w.Write([]byte(`{"status":"OK"}`))
+		}
+	}))
+	defer server.Close()
+
+	c, err := newLNURLHTTPClient(config.LNURLConfig{MaxResponseSize: 1024, Retries: -1})
+	if err != nil {
+		t.Fatalf("failed to create client %v", err)
+	}
+
+	if _, body, err := c.get(context.Background(), server.URL+"/ok"); err != nil || string(body) != `{"status":"OK"}` {
+		t.Fatalf("unexpected response %s %v", body, err)
+	}
+	if _, _, err := c.get(context.Background(), server.URL+"/large"); !errors.Is(err, ErrLNURLResponseTooLarge) {
+		t.Fatalf("expected ErrLNURLResponseTooLarge, got %v", err)
+	}
+	if _, _, err := c.get(context.Background(), server.URL+"/loop"); !errors.Is(err, ErrLNURLTooManyRedirects) {
+		t.Fatalf("expected ErrLNURLTooManyRedirects, got %v", err)
+	}
+}
+
+type denyPolicy struct {
+	host string
+}
+
+func (p *denyPolicy) AllowRequest(u *url.URL) error {
+	if u.Hostname() == p.host {
+		return errors.New("host is blocked")
+	}
+	return nil
+}
+
+func (p *denyPolicy) AllowCertificate(host string, certs []*x509.Certificate) error {
+	return nil
+}
+
+func TestLNURLPolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"OK"}`))
+	}))
+	defer server.Close()
+
+	c, err := newLNURLHTTPClient(config.LNURLConfig{Retries: -1})
+	if err != nil {
+		t.Fatalf("failed to create client %v", err)
+	}
+	c.setPolicy(&denyPolicy{host: "127.0.0.1"})
+	if _, _, err := c.get(context.Background(), server.URL); !errors.Is(err, ErrLNURLRequestDenied) {
+		t.Fatalf("expected ErrLNURLRequestDenied, got %v", err)
+	}
+	c.setPolicy(nil)
+	if _, _, err := c.get(context.Background(), server.URL); err != nil {
+		t.Fatalf("request should be allowed without a policy, got %v", err)
+	}
+}
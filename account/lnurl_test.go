@@ -0,0 +1,157 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/fiatjaf/go-lnurl"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+func TestParseLightningAddress(t *testing.T) {
+	address, addressURL, ok := parseLightningAddress("Satoshi@Example.com")
+	if !ok {
+		t.Fatalf("Satoshi@Example.com should be a lightning address")
+	}
+	if address != "satoshi@example.com" {
+		t.Fatalf("address should be satoshi@example.com, instead got %v", address)
+	}
+	if addressURL != "https://example.com/.well-known/lnurlp/satoshi" {
+		t.Fatalf("unexpected url %v", addressURL)
+	}
+
+	_, addressURL, ok = parseLightningAddress("lightning:tips@abcdefgh.onion")
+	if !ok {
+		t.Fatalf("lightning:tips@abcdefgh.onion should be a lightning address")
+	}
+	if addressURL != "http://abcdefgh.onion/.well-known/lnurlp/tips" {
+		t.Fatalf("unexpected url %v", addressURL)
+	}
+
+	for _, s := range []string{"", "satoshi", "satoshi@localhost", "sat oshi@example.com", "@example.com"} {
+		if _, _, ok := parseLightningAddress(s); ok {
+			t.Fatalf("%q should not be a lightning address", s)
+		}
+	}
+}
+
+func TestParseLUD17(t *testing.T) {
+	tests := []struct {
+		raw  string
+		url  string
+		kind string
+	}{
+		{"lnurlp://example.com/pay?id=1", "https://example.com/pay?id=1", "lnurl-pay"},
+		{"lightning:LNURLW://example.com/w", "https://example.com/w", "lnurl-withdraw"},
+		{"keyauth://example.com/auth?tag=login&k1=00", "https://example.com/auth?tag=login&k1=00", "lnurl-auth"},
+		{"lnurlc://abcdefgh.onion/c", "http://abcdefgh.onion/c", "lnurl-channel"},
+	}
+	for _, test := range tests {
+		u, kind, ok := parseLUD17(test.raw)
+		if !ok {
+			t.Fatalf("%v should be parsed", test.raw)
+		}
+		if u != test.url || kind != test.kind {
+			t.Fatalf("%v: expected %v %v, got %v %v", test.raw, test.url, test.kind, u, kind)
+		}
+	}
+
+	for _, s := range []string{"https://example.com", "lnurl1dp68gurn8ghj7", "satoshi@example.com"} {
+		if _, _, ok := parseLUD17(s); ok {
+			t.Fatalf("%v should not be parsed", s)
+		}
+	}
+}
+
+func signedChannelUpdate(t *testing.T, key *btcec.PrivateKey, feeRate uint32) string {
+	update := &lnwire.ChannelUpdate{
+		ShortChannelID: lnwire.NewShortChanIDFromInt(12345),
+		Timestamp:      1,
+		TimeLockDelta:  40,
+		BaseFee:        1000,
+		FeeRate:        feeRate,
+	}
+	data, err := update.DataToSign()
+	if err != nil {
+		t.Fatalf("failed to get data to sign %v", err)
+	}
+	sig, err := key.Sign(chainhash.DoubleHashB(data))
+	if err != nil {
+		t.Fatalf("failed to sign channel update %v", err)
+	}
+	if update.Signature, err = lnwire.NewSigFromSignature(sig); err != nil {
+		t.Fatalf("failed to convert signature %v", err)
+	}
+	var b bytes.Buffer
+	if _, err := lnwire.WriteMessage(&b, update, 0); err != nil {
+		t.Fatalf("failed to encode channel update %v", err)
+	}
+	return hex.EncodeToString(b.Bytes())
+}
+
+func TestLNURLPayRouteHint(t *testing.T) {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("failed to create key %v", err)
+	}
+	nodeID := hex.EncodeToString(key.PubKey().SerializeCompressed())
+
+	route := []lnurl.RouteInfo{{NodeId: nodeID, ChannelUpdate: signedChannelUpdate(t, key, 100)}}
+	hint, err := lnurlPayRouteHint(route, 1000000)
+	if err != nil {
+		t.Fatalf("route should be accepted, got %v", err)
+	}
+	if len(hint.HopHints) != 1 || hint.HopHints[0].ChanId != 12345 || hint.HopHints[0].CltvExpiryDelta != 40 {
+		t.Fatalf("unexpected route hint %v", hint)
+	}
+
+	otherKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("failed to create key %v", err)
+	}
+	route = []lnurl.RouteInfo{{NodeId: nodeID, ChannelUpdate: signedChannelUpdate(t, otherKey, 100)}}
+	if _, err := lnurlPayRouteHint(route, 1000000); err == nil {
+		t.Fatalf("route signed by another node should be rejected")
+	}
+
+	route = []lnurl.RouteInfo{{NodeId: nodeID, ChannelUpdate: signedChannelUpdate(t, key, 500000)}}
+	if _, err := lnurlPayRouteHint(route, 1000000000); err == nil {
+		t.Fatalf("route with excessive fees should be rejected")
+	}
+}
+
+func TestHandleBIP21(t *testing.T) {
+	a := &Service{activeParams: &chaincfg.MainNetParams}
+	res, err := a.handleBIP21(context.Background(),
+		"BITCOIN:1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2?amount=0.0005&label=Luke-Jr&message=Donation")
+	if err != nil {
+		t.Fatalf("failed to parse bip21 uri %v", err)
+	}
+	info := res.GetBip21()
+	if info == nil || info.Address != "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2" || info.Amount != 50000 ||
+		info.Label != "Luke-Jr" || info.Message != "Donation" {
+		t.Fatalf("unexpected bip21 info %v", info)
+	}
+
+	if _, err := a.handleBIP21(context.Background(), "bitcoin:1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2?amount=abc"); err == nil {
+		t.Fatalf("invalid amount should be rejected")
+	}
+}
+
+func TestJWTExpiry(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	token := "eyJhbGciOiJIUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"x","exp":1700000000}`)) + ".c2ln"
+	if exp := jwtExpiry(token, now); exp != 1700000000 {
+		t.Fatalf("expected expiry 1700000000, got %v", exp)
+	}
+	if exp := jwtExpiry("opaque-token", now); exp != now.Add(defaultLNURLAuthTokenTTL).Unix() {
+		t.Fatalf("opaque token should expire after the default ttl, got %v", exp)
+	}
+}
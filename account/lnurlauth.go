@@ -0,0 +1,250 @@
+package account
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/tv42/zbase32"
+	"github.com/tyler-smith/go-bip32"
+)
+
+const (
+	// LNURLAuthSchemeRandomKey derives the linking keys from a random bip32
+	// seed stored in breezDB. Wallets created before LUD-13 support use it.
+	LNURLAuthSchemeRandomKey = "random-key"
+
+	// LNURLAuthSchemeSignMessage derives the linking keys from the node seed
+	// using the LUD-13 signMessage scheme, so they can be recovered from the
+	// mnemonic alone.
+	LNURLAuthSchemeSignMessage = "sign-message"
+
+	// defaultLNURLAuthTokenTTL is how long a jwt token without an exp claim
+	// is reused.
+	defaultLNURLAuthTokenTTL = 24 * time.Hour
+
+	// lud13CanonicalPhrase is the message signed to derive the hashing key.
+	// Ref. https://github.com/lnurl/luds/blob/luds/13.md
+	lud13CanonicalPhrase = "DO NOT EVER SIGN THIS TEXT WITH YOUR PRIVATE KEYS! IT IS ONLY USED FOR DERIVATION OF LNURL-AUTH HASHING-KEY, DISCLOSING ITS SIGNATURE WILL COMPROMISE YOUR LNURL-AUTH IDENTITIES AND CAN LEAD TO LOSS OF FUNDS!"
+)
+
+// LNURLAuthScheme returns the scheme used to derive the lnurl auth linking keys.
+// Wallets that already have a random lnurl auth key keep using it until they
+// are migrated by SetLNURLAuthScheme.
+func (a *Service) LNURLAuthScheme() (string, error) {
+	return a.breezDB.FetchLNURLAuthScheme(func(hasKey bool) string {
+		if hasKey {
+			return LNURLAuthSchemeRandomKey
+		}
+		return LNURLAuthSchemeSignMessage
+	})
+}
+
+// SetLNURLAuthScheme switches the scheme used to derive the lnurl auth linking keys.
+// The random key is never deleted so switching back restores the old identities.
+func (a *Service) SetLNURLAuthScheme(scheme string) error {
+	if scheme != LNURLAuthSchemeRandomKey && scheme != LNURLAuthSchemeSignMessage {
+		return fmt.Errorf("unknown lnurl auth scheme %v", scheme)
+	}
+	if err := a.breezDB.SetLNURLAuthScheme(scheme); err != nil {
+		return err
+	}
+	a.requestBackup()
+	return nil
+}
+
+// ListLNURLAuthIdentities returns the identities the wallet authenticated with,
+// one per domain.
+func (a *Service) ListLNURLAuthIdentities() (*data.LNURLAuthIdentities, error) {
+	identities, err := a.breezDB.FetchLNURLAuthIdentities()
+	if err != nil {
+		return nil, err
+	}
+	return &data.LNURLAuthIdentities{Identities: identities}, nil
+}
+
+// LNURLAuthLinkingPubkey returns the hex encoded linking pubkey the wallet uses
+// to authenticate to host.
+func (a *Service) LNURLAuthLinkingPubkey(host string) (string, error) {
+	linkingPrivKey, err := a.lnurlAuthLinkingKey(host)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(linkingPrivKey.PubKey().SerializeCompressed()), nil
+}
+
+// RevokeLNURLAuthToken deletes the jwt token stored for host.
+func (a *Service) RevokeLNURLAuthToken(host string) error {
+	identity, err := a.breezDB.FetchLNURLAuthIdentity(host)
+	if err != nil {
+		return err
+	}
+	if identity == nil || identity.JwtToken == "" {
+		return nil
+	}
+	identity.JwtToken = ""
+	identity.JwtTokenExpiry = 0
+	return a.breezDB.SaveLNURLAuthIdentity(identity)
+}
+
+// GetLNURLAuthToken returns the jwt token stored for host or an empty string
+// if there is no token or it has expired.
+func (a *Service) GetLNURLAuthToken(host string) (string, error) {
+	identity, err := a.breezDB.FetchLNURLAuthIdentity(host)
+	if err != nil {
+		return "", err
+	}
+	if identity == nil || identity.JwtToken == "" {
+		return "", nil
+	}
+	if time.Now().Unix() >= identity.JwtTokenExpiry {
+		return "", nil
+	}
+	return identity.JwtToken, nil
+}
+
+// ForgetLNURLAuthIdentity deletes everything stored about the identity used to
+// authenticate to host. The linking key itself is derived and can't be deleted.
+func (a *Service) ForgetLNURLAuthIdentity(host string) error {
+	return a.breezDB.DeleteLNURLAuthIdentity(host)
+}
+
+func (a *Service) saveLNURLAuthIdentity(host string, linkingPubKey *btcec.PublicKey, token string) error {
+	scheme, err := a.LNURLAuthScheme()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	var expiry int64
+	if token != "" {
+		expiry = jwtExpiry(token, now)
+	}
+	return a.breezDB.SaveLNURLAuthIdentity(&data.LNURLAuthIdentity{
+		Host:           host,
+		LinkingPubkey:  hex.EncodeToString(linkingPubKey.SerializeCompressed()),
+		Scheme:         scheme,
+		FirstLogin:     now.Unix(),
+		LastLogin:      now.Unix(),
+		JwtToken:       token,
+		JwtTokenExpiry: expiry,
+	})
+}
+
+// jwtExpiry returns the unix time the token expires at, read from its exp
+// claim. Tokens without a readable exp claim are kept for
+// defaultLNURLAuthTokenTTL.
+func jwtExpiry(token string, now time.Time) int64 {
+	parts := strings.Split(token, ".")
+	if len(parts) == 3 {
+		payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+		if err == nil {
+			var claims struct {
+				Exp int64 `json:"exp"`
+			}
+			if err := json.Unmarshal(payload, &claims); err == nil && claims.Exp > 0 {
+				return claims.Exp
+			}
+		}
+	}
+	return now.Add(defaultLNURLAuthTokenTTL).Unix()
+}
+
+// lnurlAuthLinkingKey returns the linking key used to authenticate to host.
+func (a *Service) lnurlAuthLinkingKey(host string) (*btcec.PrivateKey, error) {
+	scheme, err := a.LNURLAuthScheme()
+	if err != nil {
+		return nil, err
+	}
+	if scheme == LNURLAuthSchemeSignMessage {
+		return a.signMessageLinkingKey(host)
+	}
+	return a.randomKeyLinkingKey(host)
+}
+
+// signMessageLinkingKey derives the linking key for host as described in LUD-13.
+func (a *Service) signMessageLinkingKey(host string) (*btcec.PrivateKey, error) {
+	lnclient := a.daemonAPI.APIClient()
+	if lnclient == nil {
+		return nil, errors.New("daemon is not ready")
+	}
+	res, err := lnclient.SignMessage(context.Background(), &lnrpc.SignMessageRequest{
+		Msg: []byte(lud13CanonicalPhrase),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign lnurl auth phrase: %w", err)
+	}
+	sig, err := zbase32.DecodeString(res.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode signature: %w", err)
+	}
+
+	hashingKey := sha256.Sum256(sig)
+	h := hmac.New(sha256.New, hashingKey[:])
+	if _, err := h.Write([]byte(host)); err != nil {
+		return nil, err
+	}
+	linkingPrivKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), h.Sum(nil))
+	return linkingPrivKey, nil
+}
+
+// randomKeyLinkingKey derives the linking key for host from the random
+// master key stored in breezDB.
+func (a *Service) randomKeyLinkingKey(host string) (*btcec.PrivateKey, error) {
+	key, err := a.getLNURLAuthKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// hash host using master key
+	h := hmac.New(sha256.New, key.Key)
+	if _, err := h.Write([]byte(host)); err != nil {
+		return nil, err
+	}
+	sha := h.Sum(nil)
+
+	// create 4 elements derivation path using hashed value.
+	first16 := sha[:16]
+	for i := 0; i < 4; i++ {
+		nextChildIndex := binary.BigEndian.Uint32(first16[i*4 : i*4+4])
+		for key, err = key.NewChildKey(nextChildIndex); err != nil; {
+			nextChildIndex++
+		}
+	}
+
+	// this is the result keypair.
+	linkingPrivKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), key.Key)
+	return linkingPrivKey, nil
+}
+
+func (a *Service) getLNURLAuthKey() (*bip32.Key, error) {
+	needsBackup := false
+	key, err := a.breezDB.FetchLNURLAuthKey(func() ([]byte, error) {
+		needsBackup = true
+		return bip32.NewSeed()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch lnurl key %w", err)
+	}
+	if needsBackup {
+		a.requestBackup()
+	}
+
+	// Create master private key from seed
+	masterKey, err := bip32.NewMasterKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("error creating lnurl master key: %w", err)
+	}
+
+	return masterKey, nil
+}
@@ -0,0 +1,87 @@
+package account
+
+import (
+	"sync"
+	"time"
+
+	"github.com/fiatjaf/go-lnurl"
+)
+
+const defaultLNURLCacheTTL = time.Minute
+
+type lnurlCacheEntry struct {
+	rawurl  string
+	params  lnurl.LNURLParams
+	expires time.Time
+}
+
+// lnurlParamsCache keeps the pay and withdraw params returned for an encoded
+// lnurl for a short time, so scanning the same static QR again doesn't need
+// another round trip. A negative ttl disables the cache.
+type lnurlParamsCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]lnurlCacheEntry
+}
+
+func newLNURLParamsCache(ttl time.Duration) *lnurlParamsCache {
+	if ttl == 0 {
+		ttl = defaultLNURLCacheTTL
+	}
+	return &lnurlParamsCache{
+		ttl:     ttl,
+		entries: make(map[string]lnurlCacheEntry),
+	}
+}
+
+func (c *lnurlParamsCache) get(encoded string) (string, lnurl.LNURLParams, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[encoded]
+	if !ok {
+		return "", nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.entries, encoded)
+		return "", nil, false
+	}
+	return entry.rawurl, entry.params, true
+}
+
+func (c *lnurlParamsCache) put(encoded, rawurl string, params lnurl.LNURLParams) {
+	if c.ttl < 0 {
+		return
+	}
+	switch params.(type) {
+	case lnurl.LNURLPayResponse1, lnurl.LNURLWithdrawResponse:
+	default:
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[encoded] = lnurlCacheEntry{
+		rawurl:  rawurl,
+		params:  params,
+		expires: now.Add(c.ttl),
+	}
+}
+
+// removeWithdraw drops the cached withdraw params with the given k1, which
+// can't be used again once the withdraw completes.
+func (c *lnurlParamsCache) removeWithdraw(k1 string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, entry := range c.entries {
+		if withdraw, ok := entry.params.(lnurl.LNURLWithdrawResponse); ok && withdraw.K1 == k1 {
+			delete(c.entries, k)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/breez/breez/account/lnurltest"
+	"github.com/breez/breez/config"
+	"github.com/breez/breez/lnnode/lnnodetest"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+func newLNURLTestService(t *testing.T) (*Service, *lnnodetest.API) {
+	a, api := newDBTestService(t)
+	lnurlHTTP, err := newLNURLHTTPClient(config.LNURLConfig{Retries: -1})
+	if err != nil {
+		t.Fatalf("failed to create lnurl client %v", err)
+	}
+	a.lnurlHTTP = lnurlHTTP
+	a.lnurlCache = newLNURLParamsCache(0)
+	a.lnurlCtx, a.lnurlCancel = context.WithCancel(context.Background())
+	return a, api
+}
+
+func newLNURLTestServer(t *testing.T) *lnurltest.Server {
+	server, err := lnurltest.NewServer(&chaincfg.SimNetParams)
+	if err != nil {
+		t.Fatalf("failed to start lnurl server %v", err)
+	}
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestLNURLAuthFlow(t *testing.T) {
+	a, _ := newLNURLTestService(t)
+	server := newLNURLTestServer(t)
+	server.Token = "token"
+	if err := a.SetLNURLAuthScheme(LNURLAuthSchemeRandomKey); err != nil {
+		t.Fatalf("failed to set auth scheme %v", err)
+	}
+
+	res, err := a.HandleLNURL(context.Background(), server.AuthLNURL())
+	if err != nil {
+		t.Fatalf("HandleLNURL failed %v", err)
+	}
+	auth := res.GetAuth()
+	if auth == nil || auth.K1 != server.K1() {
+		t.Fatalf("unexpected auth response %v", res)
+	}
+	auth.Jwt = true
+	token, err := a.FinishLNURLAuth(context.Background(), auth)
+	if err != nil {
+		t.Fatalf("FinishLNURLAuth failed %v", err)
+	}
+	if token != "token" {
+		t.Fatalf("expected token, got %v", token)
+	}
+
+	pubkey, err := a.LNURLAuthLinkingPubkey(auth.Host)
+	if err != nil {
+		t.Fatalf("failed to get linking pubkey %v", err)
+	}
+	logins := server.Logins()
+	if len(logins) != 1 || logins[0].Key != pubkey {
+		t.Fatalf("unexpected logins %v", logins)
+	}
+
+	// The stored token is reused without logging in again.
+	if token, err = a.FinishLNURLAuth(context.Background(), auth); err != nil || token != "token" {
+		t.Fatalf("expected the stored token, got %v %v", token, err)
+	}
+	if len(server.Logins()) != 1 {
+		t.Fatalf("the stored token should have been reused")
+	}
+}
+
+func TestLNURLAuthSignMessage(t *testing.T) {
+	a, api := newLNURLTestService(t)
+	server := newLNURLTestServer(t)
+	if err := a.SetLNURLAuthScheme(LNURLAuthSchemeSignMessage); err != nil {
+		t.Fatalf("failed to set auth scheme %v", err)
+	}
+
+	res, err := a.HandleLNURL(context.Background(), server.AuthLNURL())
+	if err != nil {
+		t.Fatalf("HandleLNURL failed %v", err)
+	}
+	auth := res.GetAuth()
+	if _, err := a.FinishLNURLAuth(context.Background(), auth); err != nil {
+		t.Fatalf("FinishLNURLAuth failed %v", err)
+	}
+	pubkey, err := a.LNURLAuthLinkingPubkey(auth.Host)
+	if err != nil {
+		t.Fatalf("failed to get linking pubkey %v", err)
+	}
+	if logins := server.Logins(); len(logins) != 1 || logins[0].Key != pubkey {
+		t.Fatalf("unexpected logins %v", logins)
+	}
+
+	// The linking key is derived from the node key.
+	other, err := lnnodetest.NewLightning()
+	if err != nil {
+		t.Fatalf("failed to create node %v", err)
+	}
+	api.Lightning.NodeKey = other.NodeKey
+	if otherPubkey, err := a.LNURLAuthLinkingPubkey(auth.Host); err != nil || otherPubkey == pubkey {
+		t.Fatalf("expected another linking pubkey, got %v %v", otherPubkey, err)
+	}
+}
+
+func TestLNURLWithdrawFlow(t *testing.T) {
+	a, _ := newLNURLTestService(t)
+	server := newLNURLTestServer(t)
+
+	res, err := a.HandleLNURL(context.Background(), server.WithdrawLNURL())
+	if err != nil {
+		t.Fatalf("HandleLNURL failed %v", err)
+	}
+	withdraw := res.GetWithdraw()
+	if withdraw == nil || withdraw.MinAmount != 1 || withdraw.MaxAmount != 100000 {
+		t.Fatalf("unexpected withdraw response %v", res)
+	}
+
+	invoice := newTestInvoice(t, server, 5000000)
+	if err := a.sendLNURLWithdraw(context.Background(), withdraw, invoice); err != nil {
+		t.Fatalf("sendLNURLWithdraw failed %v", err)
+	}
+	if invoices := server.WithdrawInvoices(); len(invoices) != 1 || invoices[0] != invoice {
+		t.Fatalf("unexpected withdraw invoices %v", invoices)
+	}
+
+	server.SetError(lnurltest.WithdrawCallbackPath, "already used")
+	if err := a.sendLNURLWithdraw(context.Background(), withdraw, invoice); err == nil || err.Error() != "already used" {
+		t.Fatalf("expected the service error, got %v", err)
+	}
+}
+
+func TestLNURLPayFlow(t *testing.T) {
+	a, api := newLNURLTestService(t)
+	server := newLNURLTestServer(t)
+	server.CommentAllowed = 10
+	a.cfg.LNURLCfg.PayFromNodes = true
+	peer := "02c39955c1579afe4824dc0ef4493fdf7f3760b158cf6d367d8570b9f19683afb5"
+	api.Lightning.Channels = []*lnrpc.Channel{
+		{Active: true, RemotePubkey: peer},
+		{Active: true, RemotePubkey: peer},
+	}
+
+	res, err := a.HandleLNURL(context.Background(), server.PayLNURL())
+	if err != nil {
+		t.Fatalf("HandleLNURL failed %v", err)
+	}
+	pay := res.GetPayResponse1()
+	if pay == nil || pay.Description != "lnurltest" || pay.CommentAllowed != 10 {
+		t.Fatalf("unexpected pay response %v", res)
+	}
+
+	pay.Amount = 2000000
+	pay.Comment = "thanks"
+	info, err := a.FinishLNURLPay(context.Background(), pay)
+	if err != nil {
+		t.Fatalf("FinishLNURLPay failed %v", err)
+	}
+	payments := server.Payments()
+	if len(payments) != 1 || payments[0].Invoice != info.Invoice || payments[0].Comment != "thanks" ||
+		payments[0].FromNodes != peer {
+		t.Fatalf("unexpected payments %v", payments)
+	}
+	saved, err := a.breezDB.FetchLNUrlPayInfo(info.PaymentHash)
+	if err != nil || saved == nil || saved.InvoiceDescription != "lnurltest" {
+		t.Fatalf("unexpected saved pay info %v %v", saved, err)
+	}
+
+	pay.Comment = "this comment is too long"
+	if _, err := a.FinishLNURLPay(context.Background(), pay); err == nil {
+		t.Fatalf("a long comment should be rejected")
+	}
+}
+
+func TestLNURLChannel(t *testing.T) {
+	a, _ := newLNURLTestService(t)
+	server := newLNURLTestServer(t)
+
+	res, err := a.HandleLNURL(context.Background(), server.ChannelLNURL())
+	if err != nil {
+		t.Fatalf("HandleLNURL failed %v", err)
+	}
+	channel := res.GetChannel()
+	if channel == nil || channel.K1 != server.K1() || channel.Uri != server.NodeURI {
+		t.Fatalf("unexpected channel response %v", res)
+	}
+
+	server.SetError(lnurltest.ChannelPath, "no channels")
+	a.lnurlCache = newLNURLParamsCache(-1)
+	if _, err := a.HandleLNURL(context.Background(), server.ChannelLNURL()); err == nil {
+		t.Fatalf("expected the service error")
+	}
+}
+
+func newTestInvoice(t *testing.T, server *lnurltest.Server, amount int64) string {
+	invoice, err := server.NewInvoice(amount, "withdraw")
+	if err != nil {
+		t.Fatalf("failed to create invoice %v", err)
+	}
+	return invoice
+}
@@ -0,0 +1,65 @@
+package account
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// ErrLNURLRequestDenied is returned when the LNURLPolicy refuses a request.
+var ErrLNURLRequestDenied = errors.New("lnurl request denied by policy")
+
+// LNURLPolicy lets the embedding app control which lnurl services the wallet
+// talks to. AllowRequest is called before every lnurl http request, including
+// redirects, and may block while the user is asked for confirmation.
+// AllowCertificate is called with the certificate chain presented by the
+// service during the TLS handshake after it was verified, so it can be used
+// to pin certificates. Returning an error from either method aborts the
+// request.
+type LNURLPolicy interface {
+	AllowRequest(u *url.URL) error
+	AllowCertificate(host string, certs []*x509.Certificate) error
+}
+
+// SetLNURLPolicy sets the policy applied to all the lnurl requests. A nil
+// policy allows every request.
+func (a *Service) SetLNURLPolicy(policy LNURLPolicy) {
+	a.lnurlHTTP.setPolicy(policy)
+}
+
+func (c *lnurlHTTPClient) setPolicy(policy LNURLPolicy) {
+	c.mu.Lock()
+	c.policy = policy
+	c.mu.Unlock()
+	c.transport.CloseIdleConnections()
+}
+
+func (c *lnurlHTTPClient) currentPolicy() LNURLPolicy {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.policy
+}
+
+func (c *lnurlHTTPClient) allowRequest(u *url.URL) error {
+	policy := c.currentPolicy()
+	if policy == nil {
+		return nil
+	}
+	if err := policy.AllowRequest(u); err != nil {
+		return fmt.Errorf("%w: %v: %v", ErrLNURLRequestDenied, u.Hostname(), err)
+	}
+	return nil
+}
+
+func (c *lnurlHTTPClient) verifyConnection(cs tls.ConnectionState) error {
+	policy := c.currentPolicy()
+	if policy == nil {
+		return nil
+	}
+	if err := policy.AllowCertificate(cs.ServerName, cs.PeerCertificates); err != nil {
+		return fmt.Errorf("%w: %v: %v", ErrLNURLRequestDenied, cs.ServerName, err)
+	}
+	return nil
+}
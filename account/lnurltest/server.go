@@ -0,0 +1,427 @@
+// Package lnurltest provides a local lnurl service that can be used to
+// exercise the lnurl-auth, withdraw, pay and channel flows in tests.
+package lnurltest
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/fiatjaf/go-lnurl"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+// Endpoint paths served by the server. They are also the keys used by
+// SetError.
+const (
+	AuthPath             = "/auth"
+	WithdrawPath         = "/withdraw"
+	WithdrawCallbackPath = "/withdraw/callback"
+	PayPath              = "/pay"
+	PayCallbackPath      = "/pay/callback"
+	ChannelPath          = "/channel"
+	ChannelCallbackPath  = "/channel/callback"
+)
+
+// Login is a successful lnurl-auth login received by the server.
+type Login struct {
+	K1  string
+	Key string
+}
+
+// Payment is an invoice created by the lnurl-pay callback.
+type Payment struct {
+	Amount    int64
+	Comment   string
+	FromNodes string
+	Invoice   string
+}
+
+// ChannelRequest is a request received by the lnurl-channel callback.
+type ChannelRequest struct {
+	K1       string
+	RemoteID string
+	Private  bool
+}
+
+// Server is a local lnurl service. Its exported fields configure the
+// responses and should be set before the server is used.
+type Server struct {
+	*httptest.Server
+
+	// MinWithdrawable and MaxWithdrawable are in millisatoshi.
+	MinWithdrawable int64
+	MaxWithdrawable int64
+	// MinSendable and MaxSendable are in millisatoshi.
+	MinSendable    int64
+	MaxSendable    int64
+	Metadata       lnurl.Metadata
+	CommentAllowed int64
+	SuccessAction  *lnurl.SuccessAction
+	// Token is returned to the logins that request a jwt token.
+	Token string
+	// NodeURI is returned by the lnurl-channel endpoint.
+	NodeURI string
+
+	network *chaincfg.Params
+	nodeKey *btcec.PrivateKey
+	k1      string
+
+	mu              sync.Mutex
+	errors          map[string]string
+	logins          []Login
+	withdrawInvoice []string
+	payments        []Payment
+	channelRequests []ChannelRequest
+}
+
+// NewServer starts a server creating invoices for network.
+func NewServer(network *chaincfg.Params) (*Server, error) {
+	nodeKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+	k1 := make([]byte, 32)
+	if _, err := rand.Read(k1); err != nil {
+		return nil, err
+	}
+	s := &Server{
+		MinWithdrawable: 1000,
+		MaxWithdrawable: 100000000,
+		MinSendable:     1000,
+		MaxSendable:     100000000,
+		Metadata:        lnurl.Metadata{{"text/plain", "lnurltest"}},
+		NodeURI:         hex.EncodeToString(nodeKey.PubKey().SerializeCompressed()) + "@127.0.0.1:9735",
+		network:         network,
+		nodeKey:         nodeKey,
+		k1:              hex.EncodeToString(k1),
+		errors:          make(map[string]string),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(AuthPath, s.handleAuth)
+	mux.HandleFunc(WithdrawPath, s.handleWithdraw)
+	mux.HandleFunc(WithdrawCallbackPath, s.handleWithdrawCallback)
+	mux.HandleFunc(PayPath, s.handlePay)
+	mux.HandleFunc(PayCallbackPath, s.handlePayCallback)
+	mux.HandleFunc(ChannelPath, s.handleChannel)
+	mux.HandleFunc(ChannelCallbackPath, s.handleChannelCallback)
+	s.Server = httptest.NewServer(mux)
+	return s, nil
+}
+
+// K1 returns the k1 used by all the endpoints.
+func (s *Server) K1() string {
+	return s.k1
+}
+
+// NodeKey returns the key used to sign the invoices.
+func (s *Server) NodeKey() *btcec.PrivateKey {
+	return s.nodeKey
+}
+
+// AuthLNURL returns the encoded lnurl-auth of the server.
+func (s *Server) AuthLNURL() string {
+	return s.encode(AuthPath + "?tag=login&k1=" + s.k1)
+}
+
+// WithdrawLNURL returns the encoded lnurl-withdraw of the server.
+func (s *Server) WithdrawLNURL() string {
+	return s.encode(WithdrawPath)
+}
+
+// PayLNURL returns the encoded lnurl-pay of the server.
+func (s *Server) PayLNURL() string {
+	return s.encode(PayPath)
+}
+
+// ChannelLNURL returns the encoded lnurl-channel of the server.
+func (s *Server) ChannelLNURL() string {
+	return s.encode(ChannelPath)
+}
+
+// SetError makes the endpoint at path respond with an error status and
+// reason. An empty reason removes the error.
+func (s *Server) SetError(path, reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if reason == "" {
+		delete(s.errors, path)
+		return
+	}
+	s.errors[path] = reason
+}
+
+// Logins returns the successful logins received by the server.
+func (s *Server) Logins() []Login {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Login(nil), s.logins...)
+}
+
+// WithdrawInvoices returns the invoices received by the withdraw callback.
+func (s *Server) WithdrawInvoices() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.withdrawInvoice...)
+}
+
+// Payments returns the invoices created by the pay callback.
+func (s *Server) Payments() []Payment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Payment(nil), s.payments...)
+}
+
+// ChannelRequests returns the requests received by the channel callback.
+func (s *Server) ChannelRequests() []ChannelRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]ChannelRequest(nil), s.channelRequests...)
+}
+
+func (s *Server) encode(path string) string {
+	encoded, err := lnurl.LNURLEncode(s.URL + path)
+	if err != nil {
+		panic(err)
+	}
+	return encoded
+}
+
+// respondError writes the configured error for path, if any.
+func (s *Server) respondError(w http.ResponseWriter, path string) bool {
+	s.mu.Lock()
+	reason, ok := s.errors[path]
+	s.mu.Unlock()
+	if ok {
+		writeJSON(w, lnurl.ErrorResponse(reason))
+	}
+	return ok
+}
+
+func (s *Server) handleAuth(w http.ResponseWriter, r *http.Request) {
+	if s.respondError(w, AuthPath) {
+		return
+	}
+	query := r.URL.Query()
+	if query.Get("k1") != s.k1 {
+		writeJSON(w, lnurl.ErrorResponse("unknown k1"))
+		return
+	}
+	key := query.Get("key")
+	if err := verifyAuthSignature(s.k1, query.Get("sig"), key); err != nil {
+		writeJSON(w, lnurl.ErrorResponse(err.Error()))
+		return
+	}
+
+	s.mu.Lock()
+	s.logins = append(s.logins, Login{K1: s.k1, Key: key})
+	s.mu.Unlock()
+
+	if query.Get("jwt") == "true" {
+		writeJSON(w, map[string]string{"status": "OK", "token": s.Token})
+		return
+	}
+	writeJSON(w, lnurl.OkResponse())
+}
+
+func (s *Server) handleWithdraw(w http.ResponseWriter, r *http.Request) {
+	if s.respondError(w, WithdrawPath) {
+		return
+	}
+	writeJSON(w, lnurl.LNURLWithdrawResponse{
+		Tag:                "withdrawRequest",
+		K1:                 s.k1,
+		Callback:           s.URL + WithdrawCallbackPath,
+		MinWithdrawable:    s.MinWithdrawable,
+		MaxWithdrawable:    s.MaxWithdrawable,
+		DefaultDescription: "lnurltest withdraw",
+	})
+}
+
+func (s *Server) handleWithdrawCallback(w http.ResponseWriter, r *http.Request) {
+	if s.respondError(w, WithdrawCallbackPath) {
+		return
+	}
+	query := r.URL.Query()
+	if query.Get("k1") != s.k1 {
+		writeJSON(w, lnurl.ErrorResponse("unknown k1"))
+		return
+	}
+	invoice, err := zpay32.Decode(query.Get("pr"), s.network)
+	if err != nil {
+		writeJSON(w, lnurl.ErrorResponse(err.Error()))
+		return
+	}
+	if invoice.MilliSat != nil && (int64(*invoice.MilliSat) < s.MinWithdrawable ||
+		int64(*invoice.MilliSat) > s.MaxWithdrawable) {
+		writeJSON(w, lnurl.ErrorResponse("amount out of range"))
+		return
+	}
+
+	s.mu.Lock()
+	s.withdrawInvoice = append(s.withdrawInvoice, query.Get("pr"))
+	s.mu.Unlock()
+	writeJSON(w, lnurl.OkResponse())
+}
+
+func (s *Server) encodedMetadata() string {
+	b, err := json.Marshal(s.Metadata)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (s *Server) handlePay(w http.ResponseWriter, r *http.Request) {
+	if s.respondError(w, PayPath) {
+		return
+	}
+	writeJSON(w, map[string]interface{}{
+		"tag":            "payRequest",
+		"callback":       s.URL + PayCallbackPath,
+		"minSendable":    s.MinSendable,
+		"maxSendable":    s.MaxSendable,
+		"metadata":       s.encodedMetadata(),
+		"commentAllowed": s.CommentAllowed,
+	})
+}
+
+func (s *Server) handlePayCallback(w http.ResponseWriter, r *http.Request) {
+	if s.respondError(w, PayCallbackPath) {
+		return
+	}
+	query := r.URL.Query()
+	amount, err := strconv.ParseInt(query.Get("amount"), 10, 64)
+	if err != nil || amount < s.MinSendable || amount > s.MaxSendable {
+		writeJSON(w, lnurl.ErrorResponse("invalid amount"))
+		return
+	}
+	comment := query.Get("comment")
+	if int64(len([]rune(comment))) > s.CommentAllowed {
+		writeJSON(w, lnurl.ErrorResponse("comment is too long"))
+		return
+	}
+
+	descriptionHash := sha256.Sum256([]byte(s.encodedMetadata()))
+	invoice, err := s.createInvoice(amount, zpay32.DescriptionHash(descriptionHash))
+	if err != nil {
+		writeJSON(w, lnurl.ErrorResponse(err.Error()))
+		return
+	}
+
+	s.mu.Lock()
+	s.payments = append(s.payments, Payment{
+		Amount:    amount,
+		Comment:   comment,
+		FromNodes: query.Get("fromnodes"),
+		Invoice:   invoice,
+	})
+	s.mu.Unlock()
+	writeJSON(w, lnurl.LNURLPayResponse2{
+		LNURLResponse: lnurl.OkResponse(),
+		PR:            invoice,
+		Routes:        make([][]lnurl.RouteInfo, 0),
+		SuccessAction: s.SuccessAction,
+	})
+}
+
+func (s *Server) handleChannel(w http.ResponseWriter, r *http.Request) {
+	if s.respondError(w, ChannelPath) {
+		return
+	}
+	writeJSON(w, lnurl.LNURLChannelResponse{
+		Tag:      "channelRequest",
+		K1:       s.k1,
+		Callback: s.URL + ChannelCallbackPath,
+		URI:      s.NodeURI,
+	})
+}
+
+func (s *Server) handleChannelCallback(w http.ResponseWriter, r *http.Request) {
+	if s.respondError(w, ChannelCallbackPath) {
+		return
+	}
+	query := r.URL.Query()
+	if query.Get("k1") != s.k1 {
+		writeJSON(w, lnurl.ErrorResponse("unknown k1"))
+		return
+	}
+
+	s.mu.Lock()
+	s.channelRequests = append(s.channelRequests, ChannelRequest{
+		K1:       s.k1,
+		RemoteID: query.Get("remoteid"),
+		Private:  query.Get("private") == "1",
+	})
+	s.mu.Unlock()
+	writeJSON(w, lnurl.OkResponse())
+}
+
+// NewInvoice returns an invoice for amount millisatoshi signed by the server
+// node key.
+func (s *Server) NewInvoice(amount int64, description string) (string, error) {
+	return s.createInvoice(amount, zpay32.Description(description))
+}
+
+// createInvoice creates an invoice signed by the server node key.
+func (s *Server) createInvoice(amount int64, options ...func(*zpay32.Invoice)) (string, error) {
+	var paymentHash [32]byte
+	if _, err := rand.Read(paymentHash[:]); err != nil {
+		return "", err
+	}
+	options = append(options, zpay32.Amount(lnwire.MilliSatoshi(amount)))
+	invoice, err := zpay32.NewInvoice(s.network, paymentHash, time.Now(), options...)
+	if err != nil {
+		return "", err
+	}
+	return invoice.Encode(zpay32.MessageSigner{
+		SignCompact: func(msg []byte) ([]byte, error) {
+			return btcec.SignCompact(btcec.S256(), s.nodeKey, chainhash.HashB(msg), true)
+		},
+	})
+}
+
+func verifyAuthSignature(k1, sig, key string) error {
+	k1Bytes, err := hex.DecodeString(k1)
+	if err != nil {
+		return err
+	}
+	sigBytes, err := hex.DecodeString(sig)
+	if err != nil {
+		return fmt.Errorf("invalid sig: %w", err)
+	}
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return fmt.Errorf("invalid key: %w", err)
+	}
+	signature, err := btcec.ParseDERSignature(sigBytes, btcec.S256())
+	if err != nil {
+		return fmt.Errorf("invalid sig: %w", err)
+	}
+	pubKey, err := btcec.ParsePubKey(keyBytes, btcec.S256())
+	if err != nil {
+		return fmt.Errorf("invalid key: %w", err)
+	}
+	if !signature.Verify(k1Bytes, pubKey) {
+		return errors.New("signature verification failed")
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,101 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+const (
+	maxLNURLWithdrawAttempts = 20
+)
+
+var (
+	// ErrLNURLWithdrawQueued is returned by FinishLNURLWithdraw when the
+	// service couldn't be reached and the callback will be retried later.
+	ErrLNURLWithdrawQueued = errors.New("lnurl withdraw service is unreachable, the withdrawal will be retried")
+)
+
+// isConnectivityError returns true if the request failed before a response
+// was received from the lnurl service.
+func isConnectivityError(err error) bool {
+	var urlError *url.Error
+	return errors.As(err, &urlError)
+}
+
+func (a *Service) queueLNURLWithdraw(withdraw *data.LNUrlWithdraw, bolt11 string) error {
+	return a.breezDB.SavePendingLNURLWithdraw(&data.PendingLNURLWithdraw{
+		Withdraw: withdraw,
+		Bolt11:   bolt11,
+		Created:  time.Now().Unix(),
+	})
+}
+
+// retryPendingLNURLWithdraws resends the queued withdraw callbacks and
+// notifies about the ones that completed.
+func (a *Service) retryPendingLNURLWithdraws() {
+	if !atomic.CompareAndSwapInt32(&a.retryingLNURLWithdraws, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&a.retryingLNURLWithdraws, 0)
+
+	pending, err := a.breezDB.FetchPendingLNURLWithdraws()
+	if err != nil {
+		a.log.Errorf("failed to fetch pending lnurl withdraws: %v", err)
+		return
+	}
+	for _, p := range pending {
+		if a.invoiceExpired(p.Bolt11) {
+			a.completeLNURLWithdraw(p, errors.New("invoice expired"))
+			continue
+		}
+
+		err := a.sendLNURLWithdraw(context.Background(), p.Withdraw, p.Bolt11)
+		if !isConnectivityError(err) {
+			a.completeLNURLWithdraw(p, err)
+			continue
+		}
+
+		p.Attempts++
+		if p.Attempts >= maxLNURLWithdrawAttempts {
+			a.completeLNURLWithdraw(p, err)
+			continue
+		}
+		a.log.Infof("lnurl withdraw still unreachable: %v", err)
+		if err := a.breezDB.SavePendingLNURLWithdraw(p); err != nil {
+			a.log.Errorf("failed to save pending lnurl withdraw: %v", err)
+		}
+	}
+}
+
+func (a *Service) completeLNURLWithdraw(p *data.PendingLNURLWithdraw, err error) {
+	if err := a.breezDB.DeletePendingLNURLWithdraw(p.Bolt11); err != nil {
+		a.log.Errorf("failed to delete pending lnurl withdraw: %v", err)
+	}
+	if err != nil {
+		a.log.Infof("queued lnurl withdraw failed: %v", err)
+		a.onServiceEvent(data.NotificationEvent{
+			Type: data.NotificationEvent_LNURL_WITHDRAW_FAILED,
+			Data: []string{p.Bolt11, err.Error()},
+		})
+		return
+	}
+	a.log.Infof("queued lnurl withdraw succeeded")
+	a.onServiceEvent(data.NotificationEvent{
+		Type: data.NotificationEvent_LNURL_WITHDRAW_SUCCEEDED,
+		Data: []string{p.Bolt11},
+	})
+}
+
+func (a *Service) invoiceExpired(bolt11 string) bool {
+	invoice, err := zpay32.Decode(bolt11, a.activeParams)
+	if err != nil {
+		return true
+	}
+	return time.Now().After(invoice.Timestamp.Add(invoice.Expiry()))
+}
@@ -0,0 +1,69 @@
+package account
+
+import (
+	"fmt"
+
+	"github.com/breez/breez/data"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+)
+
+const (
+	// defaultMaxParts is the maximum number of parts a payment is split into
+	// unless the user configured otherwise.
+	defaultMaxParts = 20
+
+	// maxMaxParts is the highest number of parts the user may configure.
+	maxMaxParts = 1000
+)
+
+/*
+SetMPPSettings sets the multi-path payment parameters used for every payment
+sent from now on. A zero MaxParts means the default and a zero
+MaxShardAmountSat means no limit on the size of a single part.
+*/
+func (a *Service) SetMPPSettings(settings *data.MPPSettings) error {
+	if settings.MaxParts > maxMaxParts {
+		return fmt.Errorf("max parts must not exceed %v", maxMaxParts)
+	}
+	if settings.MaxShardAmountSat < 0 {
+		return fmt.Errorf("invalid max shard amount %v", settings.MaxShardAmountSat)
+	}
+	return a.breezDB.SetMPPSettings(settings)
+}
+
+// MPPSettings returns the multi-path payment settings.
+func (a *Service) MPPSettings() (*data.MPPSettings, error) {
+	settings, err := a.breezDB.GetMPPSettings()
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		settings = &data.MPPSettings{}
+	}
+	if settings.MaxParts == 0 {
+		settings.MaxParts = defaultMaxParts
+	}
+	return settings, nil
+}
+
+// applyMPPSettings sets the multi-path payment parameters of the request
+// according to the user settings. Requests that may not be split, such as
+// payments to invoices without MPP support, are left untouched.
+func (a *Service) applyMPPSettings(req *routerrpc.SendPaymentRequest) error {
+	if req.MaxParts == 1 {
+		return nil
+	}
+	settings, err := a.MPPSettings()
+	if err != nil {
+		return err
+	}
+	if settings.Disabled {
+		req.MaxParts = 1
+		return nil
+	}
+	req.MaxParts = settings.MaxParts
+	if settings.MaxShardAmountSat > 0 {
+		req.MaxShardSizeMsat = uint64(settings.MaxShardAmountSat) * 1000
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package account
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/breez/breez/data"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+/*
+OpenChannel opens a channel funded by the onchain wallet to the node
+identified by nodeID, which is either a pubkey or pubkey@host. It returns the
+channel point once the funding transaction is published and notifies
+CHANNEL_OPEN_PENDING followed by CHANNEL_OPENED, or CHANNEL_OPEN_FAILED, with
+the channel point.
+*/
+func (a *Service) OpenChannel(nodeID string, localAmt, satPerVbyte int64, private bool) (string, error) {
+	if localAmt <= 0 {
+		return "", fmt.Errorf("invalid channel amount %v", localAmt)
+	}
+	if satPerVbyte < 0 {
+		return "", fmt.Errorf("invalid fee rate %v", satPerVbyte)
+	}
+	pubkey := nodeID
+	if i := strings.Index(nodeID, "@"); i >= 0 {
+		pubkey = nodeID[:i]
+		if err := a.ConnectPeer(pubkey, nodeID[i+1:]); err != nil {
+			return "", fmt.Errorf("failed to connect to %v: %w", nodeID, err)
+		}
+	}
+	pubkeyBytes, err := hex.DecodeString(pubkey)
+	if err != nil {
+		return "", fmt.Errorf("invalid node pubkey %v: %w", pubkey, err)
+	}
+
+	lnclient := a.daemonAPI.APIClient()
+	if lnclient == nil {
+		return "", errors.New("API is not ready")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := lnclient.OpenChannel(ctx, &lnrpc.OpenChannelRequest{
+		NodePubkey:         pubkeyBytes,
+		LocalFundingAmount: localAmt,
+		SatPerByte:         satPerVbyte,
+		Private:            private,
+	})
+	if err != nil {
+		cancel()
+		return "", err
+	}
+	update, err := stream.Recv()
+	if err != nil {
+		cancel()
+		return "", err
+	}
+	pending := update.GetChanPending()
+	if pending == nil {
+		cancel()
+		return "", fmt.Errorf("unexpected channel open update %v", update)
+	}
+	txid, err := chainhash.NewHash(pending.Txid)
+	if err != nil {
+		cancel()
+		return "", err
+	}
+	channelPoint := fmt.Sprintf("%v:%v", txid, pending.OutputIndex)
+	a.log.Infof("channel to %v pending: %v", pubkey, channelPoint)
+	a.onServiceEvent(data.NotificationEvent{
+		Type: data.NotificationEvent_CHANNEL_OPEN_PENDING,
+		Data: []string{channelPoint, pubkey},
+	})
+
+	go func() {
+		select {
+		case <-a.quitChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	go func() {
+		defer cancel()
+		for {
+			update, err := stream.Recv()
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if a.streamEndedByDaemon(err) {
+					a.log.Infof("channel %v open updates stopped: %v, waiting for the daemon", channelPoint, err)
+					a.trackOpenAfterRestart(channelPoint, pubkey)
+				} else {
+					a.log.Errorf("channel %v open failed: %v", channelPoint, err)
+					a.onServiceEvent(data.NotificationEvent{
+						Type: data.NotificationEvent_CHANNEL_OPEN_FAILED,
+						Data: []string{channelPoint, err.Error()},
+					})
+				}
+				return
+			}
+			if update.GetChanOpen() != nil {
+				a.log.Infof("channel %v opened", channelPoint)
+				a.onServiceEvent(data.NotificationEvent{
+					Type: data.NotificationEvent_CHANNEL_OPENED,
+					Data: []string{channelPoint, pubkey},
+				})
+				return
+			}
+		}
+	}()
+	return channelPoint, nil
+}
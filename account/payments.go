@@ -80,6 +80,9 @@ func (a *Service) GetPayments() (*data.PaymentsList, error) {
 			GroupKey:                   payment.GroupKey,
 			GroupName:                  payment.GroupName,
 		}
+		if len(payment.CustomRecords) > 0 {
+			paymentItem.CustomRecords = payment.CustomRecords
+		}
 		if payment.Type != db.ClosedChannelPayment {
 			paymentItem.InvoiceMemo = &data.InvoiceMemo{
 				Description:     payment.Description,
@@ -207,37 +210,56 @@ func (a *Service) SendPaymentForRequest(paymentRequest string, amountSatoshi int
 }
 
 func (a *Service) sendPaymentForRequest(paymentRequest string, amountSatoshi int64, lastHopPubkey []byte) (string, error) {
+	_, traceReport, err := a.sendPaymentForRequestWithFeeLimit(paymentRequest, amountSatoshi, lastHopPubkey, math.MaxInt64)
+	return traceReport, err
+}
+
+func (a *Service) sendPaymentForRequestWithFeeLimit(paymentRequest string, amountSatoshi int64,
+	lastHopPubkey []byte, feeLimitSat int64) (*lnrpc.Payment, string, error) {
+
 	a.log.Infof("sendPaymentForRequest: amount = %v", amountSatoshi)
 	routing.DefaultShardMinAmt = 5000
 	lnclient := a.daemonAPI.APIClient()
 	decodedReq, err := lnclient.DecodePayReq(context.Background(), &lnrpc.PayReqString{PayReq: paymentRequest})
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
 	if decodedReq.NumSatoshis == amountSatoshi {
 		amountSatoshi = 0
 	}
 	if err := a.breezDB.SavePaymentRequest(decodedReq.PaymentHash, []byte(paymentRequest)); err != nil {
-		return "", err
+		return nil, "", err
 	}
 	a.log.Infof("sendPaymentForRequest: before sending payment...")
 
-	maxParts := uint32(20)
+	maxParts := uint32(defaultMaxParts)
 	if decodedReq.Features[uint32(lnwire.MPPOptional)] == nil &&
 		decodedReq.Features[uint32(lnwire.MPPRequired)] == nil {
 		maxParts = 1
 	}
+
+	// Use the routes verified in FinishLNURLPay if this is an lnurl-pay invoice.
+	var routeHints []*lnrpc.RouteHint
+	if info, err := a.breezDB.FetchLNUrlPayInfo(decodedReq.PaymentHash); err == nil && info != nil {
+		routeHints = lnurlPayRouteHints(info)
+	}
+
 	// At this stage we are ready to send asynchronously the payment through the daemon.
-	return a.sendPayment(decodedReq.PaymentHash, decodedReq, &routerrpc.SendPaymentRequest{
+	return a.sendPaymentWithResult(decodedReq.PaymentHash, decodedReq, &routerrpc.SendPaymentRequest{
 		PaymentRequest: paymentRequest,
 		TimeoutSeconds: 60,
-		FeeLimitSat:    math.MaxInt64,
+		FeeLimitSat:    feeLimitSat,
 		MaxParts:       maxParts,
 		Amt:            amountSatoshi,
 		LastHopPubkey:  lastHopPubkey,
+		RouteHints:     routeHints,
 	})
 }
 
+// tipRecordType is the custom record used to attach a message to a keysend
+// payment.
+const tipRecordType = 7629171
+
 // SendSpontaneousPayment send a payment without a payment request.
 func (a *Service) SendSpontaneousPayment(destNode string,
 	description string, amount int64, feeLimitMSat int64,
@@ -256,7 +278,7 @@ func (a *Service) SendSpontaneousPayment(destNode string,
 		Amt:               amount,
 		TimeoutSeconds:    60,
 		FeeLimitMsat:      feeLimit,
-		MaxParts:          20,
+		MaxParts:          defaultMaxParts,
 		DestCustomRecords: make(map[uint64][]byte),
 	}
 
@@ -276,7 +298,7 @@ func (a *Service) SendSpontaneousPayment(destNode string,
 	req.DestFeatures = features
 
 	// Also use the 'tip' key to set the description.
-	req.DestCustomRecords[7629171] = []byte(description)
+	req.DestCustomRecords[tipRecordType] = []byte(description)
 	hashStr := hex.EncodeToString(hash[:])
 	if err := a.breezDB.SaveTipMessage(hashStr, []byte(description)); err != nil {
 		return "", err
@@ -375,11 +397,18 @@ func (a *Service) checkAmount(payReq *lnrpc.PayReq, sendRequest *routerrpc.SendP
 }
 
 func (a *Service) sendPayment(paymentHash string, payReq *lnrpc.PayReq, sendRequest *routerrpc.SendPaymentRequest) (string, error) {
+	_, traceReport, err := a.sendPaymentWithResult(paymentHash, payReq, sendRequest)
+	return traceReport, err
+}
+
+// sendPaymentWithResult sends the payment and returns it once it completed.
+func (a *Service) sendPaymentWithResult(paymentHash string, payReq *lnrpc.PayReq,
+	sendRequest *routerrpc.SendPaymentRequest) (*lnrpc.Payment, string, error) {
 
 	lnclient := a.daemonAPI.RouterClient()
 	if err := a.waitReadyForPayment(); err != nil {
 		a.log.Infof("sendPaymentAsync: error sending payment %v", err)
-		return "", err
+		return nil, "", err
 	}
 
 	lspReady, err := a.lspReadyPayment()
@@ -387,27 +416,37 @@ func (a *Service) sendPayment(paymentHash string, payReq *lnrpc.PayReq, sendRequ
 		a.log.Errorf("LSP is not ready for payment: %v", err)
 	}
 	if !lspReady {
-		return "", errors.New("LSP is not ready for payment")
+		return nil, "", errors.New("LSP is not ready for payment")
 	}
 
 	if err := a.checkAmount(payReq, sendRequest); err != nil {
 		a.log.Infof("sendPaymentAsync: error sending payment %v", err)
-		return "", err
+		return nil, "", err
+	}
+
+	if err := a.interceptPayment(payReq, sendRequest); err != nil {
+		return nil, "", err
+	}
+
+	if err := a.applyMPPSettings(sendRequest); err != nil {
+		return nil, "", err
 	}
 
 	a.log.Infof("sending payment with max fee = %v msat", sendRequest.FeeLimitMsat)
 	response, err := lnclient.SendPaymentV2(context.Background(), sendRequest)
 	if err != nil {
 		a.log.Infof("sendPaymentForRequest: error sending payment %v", err)
-		return "", err
+		return nil, "", err
 	}
 
+	var payment *lnrpc.Payment
 	failureReason := lnrpc.PaymentFailureReason_FAILURE_REASON_NONE
 	for {
-		payment, err := response.Recv()
+		payment, err = response.Recv()
 		if err != nil {
 			a.log.Infof("Payment event error received %v", err)
-			return "", err
+			a.paymentStats.recordPayment(nil, false)
+			return nil, "", err
 		}
 		a.log.Infof("Payment event received %v", payment.Status)
 		if payment.Status == lnrpc.Payment_IN_FLIGHT {
@@ -418,6 +457,7 @@ func (a *Service) sendPayment(paymentHash string, payReq *lnrpc.PayReq, sendRequ
 		}
 		break
 	}
+	a.paymentStats.recordPayment(payment, failureReason == lnrpc.PaymentFailureReason_FAILURE_REASON_NONE)
 
 	if failureReason != lnrpc.PaymentFailureReason_FAILURE_REASON_NONE {
 		a.log.Infof("sendPaymentForRequest finished with error, %v", failureReason.String())
@@ -432,13 +472,13 @@ func (a *Service) sendPayment(paymentHash string, payReq *lnrpc.PayReq, sendRequ
 				errorMsg += ". Try sending a smaller amount to keep the required minimum balance."
 			}
 		}
-		return traceReport, errors.New(errorMsg)
+		return nil, traceReport, errors.New(errorMsg)
 	}
 	a.log.Infof("sendPaymentForRequest finished successfully")
 	a.syncSentPayments()
 	// TODO(@nochiel) FINDOUT Should we notify client here? If we do, what breaks?
 	// a.notifyPaymentResult(true, sendRequest.PaymentRequest, paymentHash, "", "")
-	return "", nil
+	return payment, "", nil
 }
 
 /*
@@ -503,7 +543,7 @@ func (a *Service) AddInvoice(invoiceRequest *data.AddInvoiceRequest) (paymentReq
 
 		smallAmountMsat = amountMsat - channelFeesMsat
 	} else {
-		if routingHints, err = a.getLSPRoutingHints(lspInfo); err != nil {
+		if routingHints, err = a.getLSPRoutingHints(lspInfo, invoiceRequest.RouteHints, invoice.Amount); err != nil {
 			return "", 0, fmt.Errorf("failed to get LSP routing hints %w", err)
 		}
 	}
@@ -595,7 +635,14 @@ func (a *Service) getFakeChannelRoutingHint(lspInfo *data.LSPInformation) (*lnrp
 	}, nil
 }
 
-func (a *Service) getLSPRoutingHints(lspInfo *data.LSPInformation) ([]*lnrpc.RouteHint, error) {
+/*
+getLSPRoutingHints returns the hop hints of the private channels. Unless a
+selection is given, a single channel is used for every peer. The selection
+either lists the channels to use or asks for all the channels with enough
+inbound capacity to receive amountSat.
+*/
+func (a *Service) getLSPRoutingHints(lspInfo *data.LSPInformation,
+	selection *data.RouteHintSelection, amountSat int64) ([]*lnrpc.RouteHint, error) {
 
 	lnclient := a.daemonAPI.APIClient()
 	channelsRes, err := lnclient.ListChannels(context.Background(), &lnrpc.ListChannelsRequest{
@@ -604,11 +651,16 @@ func (a *Service) getLSPRoutingHints(lspInfo *data.LSPInformation) ([]*lnrpc.Rou
 	if err != nil {
 		return nil, err
 	}
+	channels, err := selectHintChannels(channelsRes.Channels, selection, amountSat)
+	if err != nil {
+		return nil, err
+	}
+	explicit := len(selection.GetChannelIds()) > 0 || selection.GetSufficientInbound()
 
 	var hints []*lnrpc.RouteHint
 	usedPeers := make(map[string]struct{})
-	for _, h := range channelsRes.Channels {
-		if _, ok := usedPeers[h.RemotePubkey]; ok {
+	for _, h := range channels {
+		if _, ok := usedPeers[h.RemotePubkey]; ok && !explicit {
 			continue
 		}
 		ci, err := lnclient.GetChanInfo(context.Background(), &lnrpc.ChanInfoRequest{
@@ -653,6 +705,45 @@ func (a *Service) getLSPRoutingHints(lspInfo *data.LSPInformation) ([]*lnrpc.Rou
 	return hints, nil
 }
 
+// selectHintChannels returns the channels selected to be used as hop hints.
+func selectHintChannels(channels []*lnrpc.Channel, selection *data.RouteHintSelection,
+	amountSat int64) ([]*lnrpc.Channel, error) {
+
+	if ids := selection.GetChannelIds(); len(ids) > 0 {
+		byID := make(map[uint64]*lnrpc.Channel, len(channels))
+		for _, c := range channels {
+			byID[c.ChanId] = c
+		}
+		var selected []*lnrpc.Channel
+		for _, id := range ids {
+			c, ok := byID[id]
+			if !ok {
+				return nil, fmt.Errorf("channel %v is not a private channel", id)
+			}
+			selected = append(selected, c)
+		}
+		return selected, nil
+	}
+
+	if selection.GetSufficientInbound() {
+		var selected []*lnrpc.Channel
+		for _, c := range channels {
+			inbound := c.RemoteBalance
+			if c.RemoteConstraints != nil {
+				inbound -= int64(c.RemoteConstraints.ChanReserveSat)
+			}
+			if inbound >= amountSat {
+				selected = append(selected, c)
+			}
+		}
+		if len(selected) == 0 {
+			return nil, fmt.Errorf("no channel has enough inbound capacity to receive %v sats", amountSat)
+		}
+		return selected, nil
+	}
+	return channels, nil
+}
+
 // SendPaymentFailureBugReport is used for investigating payment failures.
 // It should be used if the user agrees to send his payment details and the response of
 // QueryRoutes running in his node. The information is sent to the "bugreporturl" service.
@@ -1135,11 +1226,12 @@ func (a *Service) onNewSentPayment(paymentItem *lnrpc.Payment) error {
 				a.log.Infof("onNewSentPayment: No description found in this invoice. Using :%q", paymentData.Description)
 			}
 
-			if info.SuccessAction != nil && info.SuccessAction.Tag == "aes" {
-				if info.SuccessAction.Message, err = a.DecryptLNUrlPayMessage(paymentItem.PaymentHash, invoiceMemo.Preimage); err != nil {
-					a.log.Errorf("onNewSentPayment: Could not decrypt 'aes' lnurl-pay message: %s", err)
-				}
+			if info.SuccessAction != nil && info.SuccessAction.Tag == "aes" && info.SuccessAction.Message == "" {
+				a.decryptLNUrlPayMessageAndNotify(paymentItem.PaymentHash, paymentItem.PaymentPreimage)
+			}
 
+			if info.VerifyUrl != "" && !info.Settled {
+				go a.pollLNURLPayVerification(paymentItem.PaymentHash)
 			}
 		}
 
@@ -1175,6 +1267,7 @@ func (a *Service) onNewSentPayment(paymentItem *lnrpc.Payment) error {
 			if len(hops) > 0 {
 				lastHop := hops[len(hops)-1]
 				paymentData.Destination = lastHop.PubKey
+				paymentData.CustomRecords = keysendCustomRecords(lastHop.CustomRecords)
 			}
 		}
 		if groupKey != nil {
@@ -1209,6 +1302,10 @@ func (a *Service) onNewSentPayment(paymentItem *lnrpc.Payment) error {
 }
 
 func (a *Service) onNewReceivedPayment(invoice *lnrpc.Invoice) error {
+	if invoice.IsKeysend {
+		return a.onNewReceivedKeysendPayment(invoice)
+	}
+
 	var invoiceMemo *data.InvoiceMemo
 	var err error
 	if len(invoice.PaymentRequest) > 0 {
@@ -1216,6 +1313,9 @@ func (a *Service) onNewReceivedPayment(invoice *lnrpc.Invoice) error {
 			return err
 		}
 	}
+	if invoiceMemo == nil {
+		return fmt.Errorf("received payment %x without a payment request", invoice.RHash)
+	}
 
 	zeroConfPayreq, err := a.breezDB.FetchZeroConfInvoice(invoice.RHash)
 	if err != nil {
@@ -1268,6 +1368,56 @@ func (a *Service) onNewReceivedPayment(invoice *lnrpc.Invoice) error {
 	return nil
 }
 
+// onNewReceivedKeysendPayment stores a spontaneous payment we received
+// together with the custom records the sender attached to it.
+func (a *Service) onNewReceivedKeysendPayment(invoice *lnrpc.Invoice) error {
+	records := make(map[uint64][]byte)
+	for _, htlc := range invoice.Htlcs {
+		if htlc.State != lnrpc.InvoiceHTLCState_SETTLED {
+			continue
+		}
+		for k, v := range htlc.CustomRecords {
+			records[k] = v
+		}
+	}
+
+	paymentData := &db.PaymentInfo{
+		Type:              db.ReceivedPayment,
+		Amount:            invoice.AmtPaidSat,
+		CreationTimestamp: invoice.SettleDate,
+		Description:       string(records[tipRecordType]),
+		PaymentHash:       hex.EncodeToString(invoice.RHash),
+		Preimage:          hex.EncodeToString(invoice.RPreimage),
+		IsKeySend:         true,
+		CustomRecords:     keysendCustomRecords(records),
+	}
+	if _, err := a.breezDB.AddAccountPayment(paymentData, invoice.SettleIndex, 0); err != nil {
+		a.log.Errorf("Unable to add received keysend payment : %v", err)
+		return err
+	}
+	a.onServiceEvent(data.NotificationEvent{
+		Type: data.NotificationEvent_INVOICE_PAID,
+		Data: []string{paymentData.PaymentHash}})
+	a.onAccountChanged()
+	return nil
+}
+
+// keysendCustomRecords returns the custom records of a keysend payment
+// without the preimage record.
+func keysendCustomRecords(records map[uint64][]byte) map[uint64][]byte {
+	result := make(map[uint64][]byte)
+	for k, v := range records {
+		if k == record.KeySendType {
+			continue
+		}
+		result[k] = v
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
 func (a *Service) registerPayment(paymentHash, paymentSecret []byte, incomingAmountMsat,
 	outgoingAmountMsat int64, lspPubkey []byte, lspID string) error {
 
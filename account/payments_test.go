@@ -1,9 +1,14 @@
 package account
 
 import (
+	"bytes"
+	"fmt"
 	"testing"
 
 	"github.com/breez/breez/data"
+	"github.com/golang/protobuf/proto"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/record"
 )
 
 func TestOnlyDescription(t *testing.T) {
@@ -69,3 +74,57 @@ func TestWithFullCustomData(t *testing.T) {
 		t.Fatalf("invoiceMemo.PayerImageURL should be payer_image, instead got %v", invoiceMemo.PayerImageURL)
 	}
 }
+
+func TestSelectHintChannels(t *testing.T) {
+	channels := []*lnrpc.Channel{
+		{ChanId: 1, RemoteBalance: 1000, RemoteConstraints: &lnrpc.ChannelConstraints{ChanReserveSat: 100}},
+		{ChanId: 2, RemoteBalance: 50000, RemoteConstraints: &lnrpc.ChannelConstraints{ChanReserveSat: 1000}},
+		{ChanId: 3, RemoteBalance: 20000},
+	}
+	tests := []struct {
+		selection *data.RouteHintSelection
+		amount    int64
+		expected  []uint64
+		fails     bool
+	}{
+		{nil, 5000, []uint64{1, 2, 3}, false},
+		{&data.RouteHintSelection{ChannelIds: []uint64{3, 1}}, 5000, []uint64{3, 1}, false},
+		{&data.RouteHintSelection{ChannelIds: []uint64{4}}, 5000, nil, true},
+		{&data.RouteHintSelection{SufficientInbound: true}, 20000, []uint64{2, 3}, false},
+		{&data.RouteHintSelection{SufficientInbound: true}, 100000, nil, true},
+	}
+	for _, test := range tests {
+		selected, err := selectHintChannels(channels, test.selection, test.amount)
+		if test.fails {
+			if err == nil {
+				t.Fatalf("selectHintChannels(%v, %v): expected an error", test.selection, test.amount)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("selectHintChannels(%v, %v): %v", test.selection, test.amount, err)
+		}
+		var ids []uint64
+		for _, c := range selected {
+			ids = append(ids, c.ChanId)
+		}
+		if fmt.Sprint(ids) != fmt.Sprint(test.expected) {
+			t.Fatalf("selectHintChannels(%v, %v) = %v, expected %v", test.selection, test.amount, ids, test.expected)
+		}
+	}
+}
+
+func TestKeysendCustomRecordsBinary(t *testing.T) {
+	pubkey := []byte{0x02, 0xff, 0xfe, 0x80}
+	records := keysendCustomRecords(map[uint64][]byte{
+		record.KeySendType: {1, 2, 3},
+		70000:              pubkey,
+	})
+	if len(records) != 1 || !bytes.Equal(records[70000], pubkey) {
+		t.Fatalf("keysendCustomRecords = %v", records)
+	}
+	payments := &data.PaymentsList{PaymentsList: []*data.Payment{{CustomRecords: records}}}
+	if _, err := proto.Marshal(payments); err != nil {
+		t.Fatalf("proto.Marshal of binary custom records: %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package account
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"github.com/breez/breez/data"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// ExportSCB returns the current static channel backup of all the channels as
+// an encrypted multi-channel backup blob that can be restored by any lnd
+// node holding the same seed.
+func (a *Service) ExportSCB() ([]byte, error) {
+	lnclient := a.daemonAPI.APIClient()
+	if lnclient == nil {
+		return nil, errors.New("API is not ready")
+	}
+	snapshot, err := lnclient.ExportAllChannelBackups(context.Background(), &lnrpc.ChanBackupExportRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.MultiChanBackup == nil {
+		return nil, nil
+	}
+	return snapshot.MultiChanBackup.MultiChanBackup, nil
+}
+
+// watchChannelBackups notifies the hex encoded multi-channel backup every
+// time the set of channels changes.
+func (a *Service) watchChannelBackups() {
+	lnclient := a.daemonAPI.APIClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := lnclient.SubscribeChannelBackups(ctx, &lnrpc.ChannelBackupSubscription{})
+	if err != nil {
+		cancel()
+		a.log.Errorf("Failed to call SubscribeChannelBackups: %v", err)
+		return
+	}
+	defer cancel()
+	go func() {
+		select {
+		case <-a.quitChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		snapshot, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() == nil {
+				a.log.Errorf("watchChannelBackups: failed to receive a backup: %v", err)
+			}
+			return
+		}
+		if snapshot.MultiChanBackup == nil {
+			continue
+		}
+		a.log.Infof("channel backup updated for %v channels",
+			len(snapshot.MultiChanBackup.ChanPoints))
+		a.onServiceEvent(data.NotificationEvent{
+			Type: data.NotificationEvent_CHANNEL_BACKUP_UPDATED,
+			Data: []string{hex.EncodeToString(snapshot.MultiChanBackup.MultiChanBackup)},
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/breez/breez/data"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
+)
+
+/*
+SendCoins crafts a transaction sending amountSat to address and the rest of
+the selected coins back to a change address of the wallet. The change keeps
+the anchor channels reserve in the wallet. The transaction should be published
+using PublishTransaction.
+*/
+func (a *Service) SendCoins(address string, amountSat, satPerVbyte int64) (*data.SweepCoinsTransaction, error) {
+	targetAddr, err := a.sweepTargetAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	// The amount and the change follow the dust limit of the sweeps.
+	dustLimit := a.sweepDustLimit()
+	if btcutil.Amount(amountSat) < dustLimit {
+		return nil, fmt.Errorf("amount %v is below the dust limit %v", amountSat, int64(dustLimit))
+	}
+	feePerKw := chainfee.SatPerKVByte(satPerVbyte * 1000).FeePerKWeight()
+	if feePerKw < chainfee.FeePerKwFloor {
+		return nil, fmt.Errorf("fee rate of %v sat/vbyte is too low", satPerVbyte)
+	}
+
+	lnClient := a.daemonAPI.APIClient()
+	if lnClient == nil {
+		return nil, errors.New("daemon is not ready")
+	}
+	utxos, err := NewRpcUtxoSource(lnClient).ListUnspentWitness(1, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+
+	// Select the largest coins first to keep the transaction small.
+	sort.Slice(utxos, func(i, j int) bool {
+		return utxos[i].Value > utxos[j].Value
+	})
+	coins := make([]chanfunding.Coin, 0, len(utxos))
+	for _, utxo := range utxos {
+		coins = append(coins, chanfunding.Coin{
+			TxOut: wire.TxOut{
+				Value:    int64(utxo.Value),
+				PkScript: utxo.PkScript,
+			},
+			OutPoint: utxo.OutPoint,
+		})
+	}
+	selected, changeAmt, err := chanfunding.CoinSelect(feePerKw, btcutil.Amount(amountSat), coins)
+	if err != nil {
+		return nil, fmt.Errorf("chanfunding.CoinSelect: %w", err)
+	}
+
+	// The anchor reserve not covered by the coins left unspent stays in the
+	// change.
+	reserve, err := a.anchorReserve()
+	if err != nil {
+		return nil, err
+	}
+	var left btcutil.Amount
+	if changeAmt >= dustLimit {
+		left = changeAmt
+	}
+	for _, coin := range coins[len(selected):] {
+		left += btcutil.Amount(coin.Value)
+	}
+	if left < reserve {
+		selected, changeAmt, err = chanfunding.CoinSelect(feePerKw, btcutil.Amount(amountSat)+reserve, coins)
+		if err != nil {
+			return nil, fmt.Errorf("%v of the wallet coins are reserved for the anchor channels: %w", reserve, err)
+		}
+		changeAmt += reserve
+	}
+
+	targetScript, err := txscript.PayToAddrScript(targetAddr)
+	if err != nil {
+		return nil, fmt.Errorf("txscript.PayToAddrScript(%v): %w", targetAddr, err)
+	}
+	tx := wire.NewMsgTx(2)
+	var totalIn int64
+	for _, coin := range selected {
+		tx.AddTxIn(&wire.TxIn{PreviousOutPoint: coin.OutPoint, Sequence: a.txInSequence()})
+		totalIn += coin.Value
+	}
+	tx.AddTxOut(wire.NewTxOut(amountSat, targetScript))
+
+	// Change below the dust limit is left to the miners.
+	if changeAmt >= dustLimit {
+		changeScript, err := a.changeScript()
+		if err != nil {
+			return nil, err
+		}
+		tx.AddTxOut(wire.NewTxOut(int64(changeAmt), changeScript))
+	}
+
+	if err := signWalletInputs(tx, selected, NewRpcSigner(a.daemonAPI.SignerClient())); err != nil {
+		return nil, err
+	}
+
+	var amtOut int64
+	for _, output := range tx.TxOut {
+		amtOut += output.Value
+	}
+	var rawTx bytes.Buffer
+	if err := tx.Serialize(&rawTx); err != nil {
+		return nil, fmt.Errorf("tx.Serialize %#v: %w", tx, err)
+	}
+	details := &data.TransactionDetails{
+		Tx:          rawTx.Bytes(),
+		TxHash:      tx.TxHash().String(),
+		Fees:        totalIn - amtOut,
+		Replaceable: isReplaceable(tx),
+	}
+	setTxSize(details, tx, nil)
+	return &data.SweepCoinsTransaction{Amt: amountSat, Transaction: details}, nil
+}
+
+// changeAddress returns the wallet address used for change according to the
+// change policy: native segwit unless nested segwit is configured, and a
+// fresh address per transaction unless reusing unused addresses is enabled.
+func (a *Service) changeAddress() (btcutil.Address, error) {
+	addressType, err := changeAddressType(a.cfg.ChangeCfg.AddressType, a.cfg.ChangeCfg.ReuseUnused)
+	if err != nil {
+		return nil, err
+	}
+	lnClient := a.daemonAPI.APIClient()
+	res, err := lnClient.NewAddress(context.Background(), &lnrpc.NewAddressRequest{
+		Type: addressType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("lnClient.NewAddress: %w", err)
+	}
+	changeAddr, err := btcutil.DecodeAddress(res.Address, a.activeParams)
+	if err != nil {
+		return nil, fmt.Errorf("btcutil.DecodeAddress(%v): %w", res.Address, err)
+	}
+	return changeAddr, nil
+}
+
+func changeAddressType(configured string, reuseUnused bool) (lnrpc.AddressType, error) {
+	switch configured {
+	case AddressTypeP2WKH, "":
+		if reuseUnused {
+			return lnrpc.AddressType_UNUSED_WITNESS_PUBKEY_HASH, nil
+		}
+		return lnrpc.AddressType_WITNESS_PUBKEY_HASH, nil
+	case AddressTypeNP2WKH:
+		if reuseUnused {
+			return lnrpc.AddressType_UNUSED_NESTED_PUBKEY_HASH, nil
+		}
+		return lnrpc.AddressType_NESTED_PUBKEY_HASH, nil
+	}
+	return 0, fmt.Errorf("unsupported change address type %v", configured)
+}
+
+// changeScript returns the script of a new wallet address used for change.
+func (a *Service) changeScript() ([]byte, error) {
+	changeAddr, err := a.changeAddress()
+	if err != nil {
+		return nil, err
+	}
+	return txscript.PayToAddrScript(changeAddr)
+}
+
+// signWalletInputs signs all the inputs of tx, spending coins, using signer.
+func signWalletInputs(tx *wire.MsgTx, coins []chanfunding.Coin, signer *rpcSigner) error {
+	sigHashes := txscript.NewTxSigHashes(tx)
+	for i, coin := range coins {
+		txOut := coin.TxOut
+		script, err := signer.ComputeInputScript(tx, &input.SignDescriptor{
+			Output:     &txOut,
+			HashType:   txscript.SigHashAll,
+			SigHashes:  sigHashes,
+			InputIndex: i,
+		})
+		if err != nil {
+			return err
+		}
+		tx.TxIn[i].Witness = script.Witness
+		tx.TxIn[i].SignatureScript = script.SigScript
+	}
+	return nil
+}
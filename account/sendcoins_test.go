@@ -0,0 +1,40 @@
+package account
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+func TestSendCoinsDustLimit(t *testing.T) {
+	a, _ := newDBTestService(t)
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(make([]byte, 20), &chaincfg.SimNetParams)
+	if err != nil {
+		t.Fatalf("btcutil.NewAddressWitnessPubKeyHash: %v", err)
+	}
+
+	defaultLimit := int64(lnwallet.DefaultDustLimit())
+	tests := []struct {
+		dustLimit int64
+		amount    int64
+		dust      bool
+	}{
+		{dustLimit: defaultLimit + 500, amount: defaultLimit + 100, dust: true},
+		{dustLimit: defaultLimit - 300, amount: defaultLimit - 100, dust: false},
+	}
+	for _, test := range tests {
+		a.cfg.SweepCfg.DustLimit = test.dustLimit
+		// The wallet is empty so the amounts above the dust limit fail
+		// to select the coins.
+		_, err := a.SendCoins(addr.EncodeAddress(), test.amount, 5)
+		if err == nil {
+			t.Fatalf("SendCoins(%v) from an empty wallet succeeded", test.amount)
+		}
+		if dust := strings.Contains(err.Error(), "below the dust limit"); dust != test.dust {
+			t.Errorf("SendCoins(%v) with dust limit %v: %v", test.amount, test.dustLimit, err)
+		}
+	}
+}
@@ -19,8 +19,9 @@ func (a *Service) Start() error {
 		return errors.New("Account service has already started")
 	}
 
-	a.wg.Add(1)
+	a.wg.Add(2)
 	go a.watchDaemonEvents()
+	go a.watchAutoSweep()
 	return nil
 }
 
@@ -30,6 +31,7 @@ func (a *Service) Stop() error {
 		return nil
 	}
 	close(a.quitChan)
+	a.CancelLNURLRequests()
 	a.wg.Wait()
 	a.log.Infof("AccountService shutdown successfully")
 	return nil
@@ -66,19 +68,34 @@ func (a *Service) watchDaemonEvents() (err error) {
 				go a.watchPayments()
 				go a.watchCurrentInFlightPayments()
 				go a.trackZeroConfInvoice()
+				go a.trackHoldInvoices()
+				go a.retryPendingLNURLWithdraws()
+				go a.watchSavedAddresses()
+				go a.watchChannelBackups()
+				go a.checkTrackedChannels()
 				a.onAccountChanged()
 			case lnnode.TransactionEvent:
 				time.Sleep(5 * time.Second)
 				a.syncClosedChannels()
+				a.forwardTimelockedFundsClaim()
 				a.onAccountChanged()
 			case lnnode.ChannelEvent:
+				a.onTrackedChannelEvent(update.ChannelEventUpdate)
 				if update.Type == lnrpc.ChannelEventUpdate_CLOSED_CHANNEL {
 					a.syncClosedChannels()
 				}
 				a.calculateAccountAndNotify()
+			case lnnode.HtlcEvent:
+				a.onHtlcEvent(update.HtlcEvent)
 			case lnnode.BackupNeededEvent:
 				a.calculateAccountAndNotify()
+			case lnnode.ChainReorgEvent:
+				a.syncClosedChannels()
+				a.calculateAccountAndNotify()
 			case lnnode.PeerEvent:
+				if update.Type == lnrpc.PeerEvent_PEER_ONLINE {
+					go a.retryPendingLNURLWithdraws()
+				}
 				a.calculateAccountAndNotify()
 			case lnnode.DaemonDownEvent:
 				atomic.StoreInt32(&a.daemonReady, 0)
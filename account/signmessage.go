@@ -0,0 +1,182 @@
+package account
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/input"
+)
+
+// Messages are signed with the onchain keys using BIP322: the signature is
+// the witness of a virtual transaction spending an output of the address
+// committing to the message. Native segwit addresses use the "simple" format
+// (the witness only) and nested segwit ones the "full" format (the whole
+// signed transaction) since their signature script isn't empty.
+
+const bip322Tag = "BIP0322-signed-message"
+
+/*
+SignMessageWithAddress signs message with the key of address, which must
+belong to the wallet, and returns the base64 encoded BIP322 signature.
+*/
+func (a *Service) SignMessageWithAddress(address, message string) (string, error) {
+	addr, err := btcutil.DecodeAddress(address, a.activeParams)
+	if err != nil {
+		return "", fmt.Errorf("btcutil.DecodeAddress(%v): %w", address, err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return "", fmt.Errorf("txscript.PayToAddrScript(%v): %w", address, err)
+	}
+	nested := txscript.IsPayToScriptHash(pkScript)
+	if !nested && !txscript.IsPayToWitnessPubKeyHash(pkScript) {
+		return "", fmt.Errorf("unsupported address type of %v", address)
+	}
+
+	toSign := bip322ToSign(bip322ToSpend(pkScript, message))
+	signer := NewRpcSigner(a.daemonAPI.SignerClient())
+	script, err := signer.ComputeInputScript(toSign, &input.SignDescriptor{
+		Output:     wire.NewTxOut(0, pkScript),
+		HashType:   txscript.SigHashAll,
+		SigHashes:  txscript.NewTxSigHashes(toSign),
+		InputIndex: 0,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to sign with %v: %w", address, err)
+	}
+	toSign.TxIn[0].Witness = script.Witness
+	toSign.TxIn[0].SignatureScript = script.SigScript
+
+	var buf bytes.Buffer
+	if nested {
+		err = toSign.Serialize(&buf)
+	} else {
+		err = writeWitness(&buf, script.Witness)
+	}
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// VerifyMessageWithAddress verifies the BIP322 signature of message by the
+// key of address.
+func (a *Service) VerifyMessageWithAddress(address, message, signature string) (bool, error) {
+	addr, err := btcutil.DecodeAddress(address, a.activeParams)
+	if err != nil {
+		return false, fmt.Errorf("btcutil.DecodeAddress(%v): %w", address, err)
+	}
+	return verifyBIP322(addr, message, signature)
+}
+
+func verifyBIP322(addr btcutil.Address, message, signature string) (bool, error) {
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return false, fmt.Errorf("txscript.PayToAddrScript(%v): %w", addr, err)
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false, fmt.Errorf("invalid signature encoding: %w", err)
+	}
+
+	toSpend := bip322ToSpend(pkScript, message)
+	toSign := bip322ToSign(toSpend)
+	if txscript.IsPayToScriptHash(pkScript) {
+		var signed wire.MsgTx
+		if err := signed.Deserialize(bytes.NewReader(sig)); err != nil {
+			return false, fmt.Errorf("invalid signature: %w", err)
+		}
+		if len(signed.TxIn) != 1 || signed.TxIn[0].PreviousOutPoint != toSign.TxIn[0].PreviousOutPoint {
+			return false, nil
+		}
+		toSign.TxIn[0].SignatureScript = signed.TxIn[0].SignatureScript
+		toSign.TxIn[0].Witness = signed.TxIn[0].Witness
+	} else {
+		witness, err := readWitness(bytes.NewReader(sig))
+		if err != nil {
+			return false, fmt.Errorf("invalid signature: %w", err)
+		}
+		toSign.TxIn[0].Witness = witness
+	}
+
+	engine, err := txscript.NewEngine(pkScript, toSign, 0, txscript.StandardVerifyFlags,
+		nil, txscript.NewTxSigHashes(toSign), 0)
+	if err != nil {
+		return false, err
+	}
+	return engine.Execute() == nil, nil
+}
+
+// bip322MessageHash returns the BIP340 tagged hash of message.
+func bip322MessageHash(message string) []byte {
+	tag := sha256.Sum256([]byte(bip322Tag))
+	h := sha256.New()
+	h.Write(tag[:])
+	h.Write(tag[:])
+	h.Write([]byte(message))
+	return h.Sum(nil)
+}
+
+func bip322ToSpend(pkScript []byte, message string) *wire.MsgTx {
+	tx := wire.NewMsgTx(0)
+	sigScript, _ := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_0).
+		AddData(bip322MessageHash(message)).
+		Script()
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{}, Index: 0xffffffff},
+		SignatureScript:  sigScript,
+		Sequence:         0,
+	})
+	tx.AddTxOut(wire.NewTxOut(0, pkScript))
+	return tx
+}
+
+func bip322ToSign(toSpend *wire.MsgTx) *wire.MsgTx {
+	tx := wire.NewMsgTx(0)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: toSpend.TxHash(), Index: 0},
+		Sequence:         0,
+	})
+	tx.AddTxOut(wire.NewTxOut(0, []byte{txscript.OP_RETURN}))
+	return tx
+}
+
+func writeWitness(buf *bytes.Buffer, witness wire.TxWitness) error {
+	if err := wire.WriteVarInt(buf, 0, uint64(len(witness))); err != nil {
+		return err
+	}
+	for _, item := range witness {
+		if err := wire.WriteVarBytes(buf, 0, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readWitness(r *bytes.Reader) (wire.TxWitness, error) {
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	if count > uint64(r.Len()) {
+		return nil, errors.New("invalid witness size")
+	}
+	witness := make(wire.TxWitness, count)
+	for i := range witness {
+		if witness[i], err = wire.ReadVarBytes(r, 0, txscript.MaxScriptSize, "witness"); err != nil {
+			return nil, err
+		}
+	}
+	if r.Len() > 0 {
+		return nil, errors.New("unexpected data after the witness")
+	}
+	return witness, nil
+}
@@ -0,0 +1,54 @@
+package account
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+)
+
+// Test vectors from BIP322.
+func TestBIP322(t *testing.T) {
+	addr, err := btcutil.DecodeAddress("bc1q9vza2e8x573nczrlzms0wvx3gsqjx7vavgkx0l", &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("btcutil.DecodeAddress: %v", err)
+	}
+	pkScript, _ := txscript.PayToAddrScript(addr)
+
+	tests := []struct {
+		message     string
+		messageHash string
+		toSpend     string
+		signature   string
+	}{
+		{
+			"",
+			"c90c269c4f8fcbe6880f72a721ddfbf1914268a794cbb21cfafee13770ae19f1",
+			"c5680aa69bb8d860bf82d4e9cd3504b55dde018de765a91bb566283c545a99a7",
+			"AkcwRAIgM2gBAQqvZX15ZiysmKmQpDrG83avLIT492QBzLnQIxYCIBaTpOaD20qRlEylyxFSeEA2ba9YOixpX8z46TSDtS40ASECx/EgAxlkQpQ9hYjgGu6EBCPMVPwVIVJqO4XCsMvViHI=",
+		},
+		{
+			"Hello World",
+			"f0eb03b1a75ac6d9847f55c624a99169b5dccba2a31f5b23bea77ba270de0a7a",
+			"b79d196740ad5217771c1098fc4a4b51e0535c32236c71f1ea4d61a2d603352b",
+			"AkcwRAIgZRfIY3p7/DoVTty6YZbWS71bc5Vct9p9Fia83eRmw2QCICK/ENGfwLtptFluMGs2KsqoNSk89pO7F29zJLUx9a/sASECx/EgAxlkQpQ9hYjgGu6EBCPMVPwVIVJqO4XCsMvViHI=",
+		},
+	}
+	for _, test := range tests {
+		if h := hex.EncodeToString(bip322MessageHash(test.message)); h != test.messageHash {
+			t.Errorf("message hash of %q: %v, expected %v", test.message, h, test.messageHash)
+		}
+		if txid := bip322ToSpend(pkScript, test.message).TxHash().String(); txid != test.toSpend {
+			t.Errorf("to_spend of %q: %v, expected %v", test.message, txid, test.toSpend)
+		}
+		ok, err := verifyBIP322(addr, test.message, test.signature)
+		if err != nil || !ok {
+			t.Errorf("signature of %q not verified: %v", test.message, err)
+		}
+		if ok, _ := verifyBIP322(addr, test.message+"!", test.signature); ok {
+			t.Errorf("signature of %q verified for another message", test.message)
+		}
+	}
+}
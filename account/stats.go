@@ -0,0 +1,49 @@
+package account
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// paymentStats counts the payments sent since the service was created.
+type paymentStats struct {
+	sync.Mutex
+	succeeded        int64
+	failed           int64
+	pathfindingTotal time.Duration
+	pathfindingCount int64
+}
+
+// recordPayment counts the payment result. The pathfinding time is the time
+// from the payment creation to its first htlc attempt.
+func (s *paymentStats) recordPayment(payment *lnrpc.Payment, succeeded bool) {
+	s.Lock()
+	defer s.Unlock()
+	if succeeded {
+		s.succeeded++
+	} else {
+		s.failed++
+	}
+	if payment == nil || len(payment.Htlcs) == 0 || payment.CreationTimeNs == 0 {
+		return
+	}
+	pathfinding := time.Duration(payment.Htlcs[0].AttemptTimeNs - payment.CreationTimeNs)
+	if pathfinding < 0 {
+		return
+	}
+	s.pathfindingTotal += pathfinding
+	s.pathfindingCount++
+}
+
+// PaymentStats returns the number of payments that succeeded and failed since
+// the service was created and their average pathfinding time.
+func (a *Service) PaymentStats() (succeeded, failed int64, avgPathfinding time.Duration) {
+	a.paymentStats.Lock()
+	defer a.paymentStats.Unlock()
+	if a.paymentStats.pathfindingCount > 0 {
+		avgPathfinding = a.paymentStats.pathfindingTotal / time.Duration(a.paymentStats.pathfindingCount)
+	}
+	return a.paymentStats.succeeded, a.paymentStats.failed, avgPathfinding
+}
@@ -0,0 +1,29 @@
+package account
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+func TestPaymentStats(t *testing.T) {
+	a := &Service{}
+	a.paymentStats.recordPayment(&lnrpc.Payment{
+		CreationTimeNs: int64(time.Second),
+		Htlcs:          []*lnrpc.HTLCAttempt{{AttemptTimeNs: int64(time.Second + 100*time.Millisecond)}},
+	}, true)
+	a.paymentStats.recordPayment(&lnrpc.Payment{
+		CreationTimeNs: int64(time.Second),
+		Htlcs:          []*lnrpc.HTLCAttempt{{AttemptTimeNs: int64(time.Second + 300*time.Millisecond)}},
+	}, false)
+	a.paymentStats.recordPayment(nil, false)
+
+	succeeded, failed, avgPathfinding := a.PaymentStats()
+	if succeeded != 1 || failed != 2 {
+		t.Fatalf("expected 1 succeeded and 2 failed payments, got %v and %v", succeeded, failed)
+	}
+	if avgPathfinding != 200*time.Millisecond {
+		t.Fatalf("expected an average pathfinding time of 200ms, got %v", avgPathfinding)
+	}
+}
@@ -7,25 +7,45 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 
 	"github.com/breez/breez/data"
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/psbt"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
 	"github.com/lightningnetwork/lnd/sweep"
 )
 
-var targets = []int{2, 6, 25}
+// sweepConfTargets are the conf targets of the transactions crafted by
+// SweepAllCoinsTransactions.
+var sweepConfTargets = []int32{2, 6, 25}
+
+// ErrOwnWalletAddress is returned when the coins are sent to an address of
+// the wallet they are taken from.
+var ErrOwnWalletAddress = errors.New("the address belongs to this wallet")
 
 func (a *Service) PublishTransaction(txHex []byte) error {
+	return a.PublishTransactionWithLabel(txHex, "")
+}
+
+/*
+PublishTransactionWithLabel publishes the transaction and, if label isn't
+empty, labels it in the lnd wallet and in breezDB. The transaction is also
+pushed to the configured external broadcasters.
+*/
+func (a *Service) PublishTransactionWithLabel(txHex []byte, label string) error {
 	walletKitClient := a.daemonAPI.WalletKitClient()
 	pr, err := walletKitClient.PublishTransaction(context.Background(), &walletrpc.Transaction{TxHex: txHex})
 	if err != nil {
@@ -36,27 +56,227 @@ func (a *Service) PublishTransaction(txHex []byte) error {
 		a.log.Errorf("walletKitClient.PublishTransaction(%x): %v", txHex, pr.PublishError)
 		return fmt.Errorf("walletKitClient.PublishTransaction(%x): %v", txHex, pr.PublishError)
 	}
+	a.broadcastExternally(txHex)
 
+	var tx wire.MsgTx
+	if err := tx.Deserialize(bytes.NewReader(txHex)); err != nil {
+		return fmt.Errorf("tx.Deserialize: %w", err)
+	}
+	txid := tx.TxHash()
+	if err := a.saveCraftedSweep(txid.String()); err != nil {
+		a.log.Errorf("failed to save the sweep package: %v", err)
+	}
+	if label != "" {
+		if err := a.labelTransaction(txid, label); err != nil {
+			a.log.Errorf("failed to label transaction: %v", err)
+		}
+	}
 	return nil
 }
 
-func (a *Service) determineFeePerKw(confTarget int) (chainfee.SatPerKWeight, error) {
-	walletKitClient := a.daemonAPI.WalletKitClient()
-	if walletKitClient == nil {
-		return 0, fmt.Errorf("API not ready")
+func (a *Service) labelTransaction(txid chainhash.Hash, label string) error {
+	if err := a.breezDB.SaveTxLabel(txid.String(), label); err != nil {
+		return fmt.Errorf("breezDB.SaveTxLabel: %w", err)
 	}
-	feeResponse, err := walletKitClient.EstimateFee(context.Background(),
-		&walletrpc.EstimateFeeRequest{ConfTarget: int32(confTarget)})
+	_, err := a.daemonAPI.WalletKitClient().LabelTransaction(context.Background(), &walletrpc.LabelTransactionRequest{
+		Txid:      txid[:],
+		Label:     label,
+		Overwrite: true,
+	})
 	if err != nil {
-		return 0, fmt.Errorf("walletKitClient.EstimateFee(%v): %w", confTarget, err)
+		return fmt.Errorf("walletKitClient.LabelTransaction(%v): %w", txid, err)
 	}
-	return chainfee.SatPerKWeight(feeResponse.SatPerKw), nil
+	return nil
+}
+
+func (a *Service) determineFeePerKw(confTarget int) (chainfee.SatPerKWeight, error) {
+	return a.feeEstimator.EstimateFeePerKw(confTarget)
 }
 
 /*
 SweepAllCoinsTransactions executes a request to send wallet coins to a particular address.
+A transaction is crafted for each of the sweep conf targets, keyed by the conf
+target, at the fee rate FeeRates returns for it.
 */
 func (a *Service) SweepAllCoinsTransactions(address string) (*data.SweepAllCoinsTransactions, error) {
+	return a.sweepAllCoinsTransactions([]*data.SweepDestination{{Address: address}}, 1, false)
+}
+
+/*
+SweepAllCoinsTransactionsWithMinConfs is like SweepAllCoinsTransactions but
+only the outputs with at least minConfs confirmations are swept. A minConfs of
+0 includes the unconfirmed outputs, such as change of a pending transaction.
+*/
+func (a *Service) SweepAllCoinsTransactionsWithMinConfs(address string, minConfs int32) (*data.SweepAllCoinsTransactions, error) {
+	if minConfs < 0 {
+		return nil, fmt.Errorf("invalid min confirmations %v", minConfs)
+	}
+	return a.sweepAllCoinsTransactions([]*data.SweepDestination{{Address: address}}, minConfs, false)
+}
+
+/*
+SweepAllCoinsToDestinations is like SweepAllCoinsTransactions but the coins
+are distributed between several destinations in one transaction.
+*/
+func (a *Service) SweepAllCoinsToDestinations(destinations []*data.SweepDestination) (*data.SweepAllCoinsTransactions, error) {
+	return a.sweepAllCoinsTransactions(destinations, 1, false)
+}
+
+/*
+SweepAllCoinsPsbts is like SweepAllCoinsTransactions but the transactions are
+left unsigned and returned as PSBTs as well, so they can be reviewed or signed
+using external tools.
+*/
+func (a *Service) SweepAllCoinsPsbts(address string) (*data.SweepAllCoinsTransactions, error) {
+	return a.sweepAllCoinsTransactions([]*data.SweepDestination{{Address: address}}, 1, true)
+}
+
+func (a *Service) sweepAllCoinsTransactions(destinations []*data.SweepDestination, minConfs int32, unsigned bool) (*data.SweepAllCoinsTransactions, error) {
+	lnClient := a.daemonAPI.APIClient()
+	info, err := lnClient.GetInfo(context.Background(), &lnrpc.GetInfoRequest{})
+	if err != nil {
+		a.log.Errorf("lnClient.GetInfo: %v", err)
+		return nil, fmt.Errorf("lnClient.GetInfo: %w", err)
+	}
+	// The wallet outputs are listed once and used for all the conf targets.
+	rus := NewRpcUtxoSource(lnClient)
+	rus.dustLimit = a.sweepDustLimit()
+	rus.minConfs = minConfs
+	utxos, err := rus.ListUnspentWitness(minConfs, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+	keep, err := a.anchorReserveToKeep(utxos)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := a.sweepTargetAddresses(destinations)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := a.FeeRates(sweepConfTargets)
+	if err != nil {
+		return nil, fmt.Errorf("a.FeeRates(%v): %w", sweepConfTargets, err)
+	}
+	td := make(map[int32]*data.TransactionDetails)
+	var totalAmount int64
+	for _, confTarget := range sweepConfTargets {
+		feePerKw := chainfee.SatPerKVByte(rates[confTarget] * 1000).FeePerKWeight()
+		fixed := newFixedUtxoSource(utxos)
+		fixed.dustLimit = rus.dustLimit
+		details, amount, err := a.craftSweepToDestinations(destinations, addrs, feePerKw, info.BlockHeight, fixed, keep, unsigned)
+		if err != nil {
+			// ignore validation errors of crafting specific transaction.
+			var ruleErr blockchain.RuleError
+			if errors.As(err, &ruleErr) {
+				continue
+			}
+			return nil, err
+		}
+		details.ConfTarget = confTarget
+		td[confTarget] = details
+		totalAmount = amount
+	}
+	dustUtxos := make([]*data.UTXO, 0, len(rus.dust))
+	for _, utxo := range rus.dust {
+		dustUtxos = append(dustUtxos, &data.UTXO{
+			Outpoint: &data.OutPoint{
+				Txid:        utxo.OutPoint.Hash.String(),
+				OutputIndex: utxo.OutPoint.Index,
+			},
+			Amount:        int64(utxo.Value),
+			Confirmations: utxo.Confirmations,
+		})
+	}
+	return &data.SweepAllCoinsTransactions{Amt: totalAmount, Transactions: td, DustUtxos: dustUtxos}, nil
+}
+
+// sweepDustLimit returns the configured dust limit used when sweeping all
+// the wallet coins, or the lnd default.
+func (a *Service) sweepDustLimit() btcutil.Amount {
+	if a.cfg.SweepCfg.DustLimit > 0 {
+		return btcutil.Amount(a.cfg.SweepCfg.DustLimit)
+	}
+	return lnwallet.DefaultDustLimit()
+}
+
+/*
+SweepCoinsTransaction crafts a transaction sending all the wallet coins, or
+only the requested outpoints, to the requested address or destinations using
+the exact fee rate of the request.
+*/
+func (a *Service) SweepCoinsTransaction(request *data.SweepCoinsRequest) (*data.SweepCoinsTransaction, error) {
+	destinations := request.Destinations
+	if len(destinations) == 0 {
+		destinations = []*data.SweepDestination{{Address: request.Address}}
+	}
+
+	feePerKw := chainfee.SatPerKVByte(request.SatPerVbyte * 1000).FeePerKWeight()
+	if feePerKw < chainfee.FeePerKwFloor {
+		return nil, fmt.Errorf("fee rate of %v sat/vbyte is too low", request.SatPerVbyte)
+	}
+
+	lnClient := a.daemonAPI.APIClient()
+	info, err := lnClient.GetInfo(context.Background(), &lnrpc.GetInfoRequest{})
+	if err != nil {
+		a.log.Errorf("lnClient.GetInfo: %v", err)
+		return nil, fmt.Errorf("lnClient.GetInfo: %w", err)
+	}
+	rus := NewRpcUtxoSource(lnClient)
+	if len(request.Outpoints) > 0 {
+		if rus.outpoints, err = decodeOutPoints(request.Outpoints); err != nil {
+			return nil, err
+		}
+	}
+	swept, err := rus.ListUnspentWitness(rus.minConfs, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+	keep, err := a.anchorReserveToKeep(swept)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := a.sweepTargetAddresses(destinations)
+	if err != nil {
+		return nil, err
+	}
+	details, amount, err := a.craftSweepToDestinations(destinations, addrs, feePerKw, info.BlockHeight, rus, keep, false)
+	if err != nil {
+		return nil, err
+	}
+	return &data.SweepCoinsTransaction{Amt: amount, Transaction: details}, nil
+}
+
+// sweepTargetAddress decodes the address receiving the swept coins.
+func (a *Service) sweepTargetAddress(address string) (btcutil.Address, error) {
+	own, err := a.walletAddresses()
+	if err != nil {
+		return nil, err
+	}
+	return a.checkSweepTarget(address, own)
+}
+
+// sweepTargetAddresses decodes the addresses of destinations, looking up the
+// wallet addresses once for all of them.
+func (a *Service) sweepTargetAddresses(destinations []*data.SweepDestination) ([]btcutil.Address, error) {
+	own, err := a.walletAddresses()
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]btcutil.Address, 0, len(destinations))
+	for _, d := range destinations {
+		addr, err := a.checkSweepTarget(d.Address, own)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// checkSweepTarget decodes address and makes sure it isn't one of own, the
+// addresses of the wallet.
+func (a *Service) checkSweepTarget(address string, own map[string]struct{}) (btcutil.Address, error) {
 
 	// Decode the address receiving the coins, we need to check whether the
 	// address is valid for this network.
@@ -81,60 +301,273 @@ func (a *Service) SweepAllCoinsTransactions(address string) (*data.SweepAllCoins
 		return nil, fmt.Errorf("cannot send coins to pubkeys")
 	}
 
-	lnClient := a.daemonAPI.APIClient()
-	info, err := lnClient.GetInfo(context.Background(), &lnrpc.GetInfoRequest{})
-	if err != nil {
-		a.log.Errorf("lnClient.GetInfo: %v", err)
-		return nil, fmt.Errorf("lnClient.GetInfo: %w", err)
+	// Sending to our own wallet only burns fees.
+	if _, ok := own[targetAddr.String()]; ok {
+		return nil, fmt.Errorf("%w: %v", ErrOwnWalletAddress, targetAddr)
 	}
-	td := make(map[int32]*data.TransactionDetails)
-	var totalAmount int64
-	for _, confTarget := range targets {
-		feePerKw, err := a.determineFeePerKw(confTarget)
+	return targetAddr, nil
+}
+
+// craftSweepToDestinations crafts a transaction sending all the coins of rus
+// to destinations, whose decoded addresses are addrs. Destinations with an
+// amount receive exactly that amount and the rest is split between the other
+// destinations according to their ratio. If all the destinations have an
+// amount the rest goes back to the wallet. keep is sent back to the wallet as
+// well, for the anchor reserve.
+func (a *Service) craftSweepToDestinations(destinations []*data.SweepDestination, addrs []btcutil.Address,
+	feePerKw chainfee.SatPerKWeight, blockHeight uint32, rus *rpcUtxoSource, keep btcutil.Amount,
+	unsigned bool) (*data.TransactionDetails, int64, error) {
+
+	var fixed []sweep.DeliveryAddr
+	var fixedAmount int64
+	var ratioAddrs []btcutil.Address
+	var ratios []int64
+	var totalRatio int64
+	for i, d := range destinations {
+		addr := addrs[i]
+		if d.Amount > 0 {
+			fixed = append(fixed, sweep.DeliveryAddr{Addr: addr, Amt: btcutil.Amount(d.Amount)})
+			fixedAmount += d.Amount
+			continue
+		}
+		ratio := int64(d.Ratio)
+		if ratio == 0 {
+			ratio = 1
+		}
+		ratioAddrs = append(ratioAddrs, addr)
+		ratios = append(ratios, ratio)
+		totalRatio += ratio
+	}
+	if len(ratioAddrs) == 0 {
+		changeAddr, err := a.changeAddress()
+		if err != nil {
+			return nil, 0, err
+		}
+		details, amount, err := a.craftSweepAllTx(changeAddr, fixed, feePerKw, blockHeight, rus, unsigned)
+		if err != nil {
+			return nil, 0, err
+		}
+		if btcutil.Amount(amount-details.Fees-fixedAmount) < keep {
+			return nil, 0, fmt.Errorf("%v of the wallet coins are reserved for the anchor channels", keep)
+		}
+		return details, amount, nil
+	}
+	if keep > 0 {
+		changeAddr, err := a.changeAddress()
 		if err != nil {
-			return nil, fmt.Errorf("a.determineFeePerKw(%v): %w", confTarget, err)
-		}
-		rus := NewRpcUtxoSource(lnClient)
-		sweepTxPkg, err := sweep.CraftSweepAllTx(
-			feePerKw,
-			lnwallet.DefaultDustLimit(),
-			info.BlockHeight,
-			nil,
-			targetAddr,
-			&nilCoinSelectionLocker,
-			rus,
-			&nilOutpointLocker,
-			nil,
-			NewRpcSigner(a.daemonAPI.SignerClient()),
-		)
+			return nil, 0, err
+		}
+		fixed = append(fixed, sweep.DeliveryAddr{Addr: changeAddr, Amt: keep})
+		fixedAmount += int64(keep)
+	}
 
+	last := len(ratioAddrs) - 1
+	if last == 0 {
+		details, amount, err := a.craftSweepAllTx(ratioAddrs[0], fixed, feePerKw, blockHeight, rus, unsigned)
 		if err != nil {
-			// ignore validation errors of crafting specific transaction.
-			var ruleErr blockchain.RuleError
-			if errors.As(err, &ruleErr) {
-				continue
-			}
-			return nil, fmt.Errorf("sweep.CraftSweepAllTx(): %w", err)
+			return nil, 0, err
 		}
+		return details, amount - int64(keep), nil
+	}
 
-		var amtOut int64
-		for _, output := range sweepTxPkg.SweepTx.TxOut {
-			amtOut += output.Value
+	// The fee doesn't depend on the output amounts, so we first craft the
+	// transaction with placeholder amounts to know how much is left to split.
+	dustLimit := rus.outputDustLimit()
+	deliveryAddrs := append([]sweep.DeliveryAddr{}, fixed...)
+	for _, addr := range ratioAddrs[:last] {
+		deliveryAddrs = append(deliveryAddrs, sweep.DeliveryAddr{Addr: addr, Amt: dustLimit})
+	}
+	details, amount, err := a.craftSweepAllTx(ratioAddrs[last], deliveryAddrs, feePerKw, blockHeight, rus, true)
+	if err != nil {
+		return nil, 0, err
+	}
+	left := amount - details.Fees - fixedAmount
+
+	deliveryAddrs = append([]sweep.DeliveryAddr{}, fixed...)
+	for i, addr := range ratioAddrs[:last] {
+		amt := btcutil.Amount(left * ratios[i] / totalRatio)
+		if amt < dustLimit {
+			return nil, 0, fmt.Errorf("the amount sent to %v is below the dust limit", addr)
 		}
+		deliveryAddrs = append(deliveryAddrs, sweep.DeliveryAddr{Addr: addr, Amt: amt})
+	}
+	details, amount, err = a.craftSweepAllTx(ratioAddrs[last], deliveryAddrs, feePerKw, blockHeight, rus, unsigned)
+	if err != nil {
+		return nil, 0, err
+	}
+	return details, amount - int64(keep), nil
+}
 
-		var rawTx bytes.Buffer
-		err = sweepTxPkg.SweepTx.Serialize(&rawTx)
+// craftSweepAllTx crafts a transaction sending all the coins of rus to
+// deliveryAddrs and the rest to targetAddr. It returns the transaction details and the total amount swept.
+// If unsigned is true the transaction isn't signed and is also returned as a
+// PSBT.
+func (a *Service) craftSweepAllTx(targetAddr btcutil.Address, deliveryAddrs []sweep.DeliveryAddr, feePerKw chainfee.SatPerKWeight,
+	blockHeight uint32, rus *rpcUtxoSource, unsigned bool) (*data.TransactionDetails, int64, error) {
+
+	// The transaction is signed after setting the sequence of its inputs.
+	sweepTxPkg, err := sweep.CraftSweepAllTx(
+		feePerKw,
+		rus.outputDustLimit(),
+		blockHeight,
+		deliveryAddrs,
+		targetAddr,
+		&nilCoinSelectionLocker,
+		rus,
+		&nilOutpointLocker,
+		nil,
+		&nopSigner{},
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("sweep.CraftSweepAllTx(): %w", err)
+	}
+	sequence := a.txInSequence()
+	for _, txIn := range sweepTxPkg.SweepTx.TxIn {
+		txIn.Sequence = sequence
+	}
+	if !unsigned {
+		coins, err := txInputsCoins(sweepTxPkg.SweepTx, rus.listed)
 		if err != nil {
-			return nil, fmt.Errorf("tx.Serialize %#v: %w", sweepTxPkg.SweepTx, err)
+			return nil, 0, err
+		}
+		if err := signWalletInputs(sweepTxPkg.SweepTx, coins, NewRpcSigner(a.daemonAPI.SignerClient())); err != nil {
+			return nil, 0, err
+		}
+		if err := a.keepSweepPackage(sweepTxPkg.SweepTx, coins, targetAddr); err != nil {
+			a.log.Errorf("failed to keep the sweep package: %v", err)
+		}
+	}
+
+	var amtOut int64
+	for _, output := range sweepTxPkg.SweepTx.TxOut {
+		amtOut += output.Value
+	}
+
+	var rawTx bytes.Buffer
+	err = sweepTxPkg.SweepTx.Serialize(&rawTx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("tx.Serialize %#v: %w", sweepTxPkg.SweepTx, err)
+	}
+	details := &data.TransactionDetails{
+		Tx:          rawTx.Bytes(),
+		TxHash:      sweepTxPkg.SweepTx.TxHash().String(),
+		Fees:        rus.totalAmount - amtOut,
+		Replaceable: isReplaceable(sweepTxPkg.SweepTx),
+	}
+	if unsigned {
+		if details.Psbt, err = sweepPsbt(sweepTxPkg.SweepTx, rus.listed); err != nil {
+			return nil, 0, err
 		}
-		td[int32(confTarget)] = &data.TransactionDetails{
-			Tx:     rawTx.Bytes(),
-			TxHash: sweepTxPkg.SweepTx.TxHash().String(),
-			Fees:   rus.totalAmount - amtOut,
+	}
+	setTxSize(details, sweepTxPkg.SweepTx, rus.listed)
+	return details, rus.totalAmount, nil
+}
+
+// txInSequence returns the sequence of the inputs of the crafted
+// transactions. Unless configured otherwise they signal BIP125
+// replaceability.
+func (a *Service) txInSequence() uint32 {
+	if a.cfg.SweepCfg.DisableRBF {
+		return wire.MaxTxInSequenceNum - 1
+	}
+	return mempool.MaxRBFSequence
+}
+
+// isReplaceable returns true if tx signals BIP125 replaceability.
+func isReplaceable(tx *wire.MsgTx) bool {
+	for _, txIn := range tx.TxIn {
+		if txIn.Sequence <= mempool.MaxRBFSequence {
+			return true
+		}
+	}
+	return false
+}
+
+// txInputsCoins returns the coins spent by the inputs of tx, in order.
+func txInputsCoins(tx *wire.MsgTx, utxos []*lnwallet.Utxo) ([]chanfunding.Coin, error) {
+	byOutPoint := make(map[wire.OutPoint]*lnwallet.Utxo, len(utxos))
+	for _, utxo := range utxos {
+		byOutPoint[utxo.OutPoint] = utxo
+	}
+	coins := make([]chanfunding.Coin, 0, len(tx.TxIn))
+	for _, txIn := range tx.TxIn {
+		utxo, ok := byOutPoint[txIn.PreviousOutPoint]
+		if !ok {
+			return nil, fmt.Errorf("unknown input %v", txIn.PreviousOutPoint)
 		}
-		totalAmount = rus.totalAmount
+		coins = append(coins, chanfunding.Coin{
+			TxOut: wire.TxOut{
+				Value:    int64(utxo.Value),
+				PkScript: utxo.PkScript,
+			},
+			OutPoint: utxo.OutPoint,
+		})
 	}
-	return &data.SweepAllCoinsTransactions{Amt: totalAmount, Transactions: td}, nil
+	return coins, nil
+}
+
+// setTxSize sets the virtual size and the effective fee rate of the
+// transaction tx spending utxos. The size of an unsigned transaction is
+// estimated from the types of its inputs.
+func setTxSize(details *data.TransactionDetails, tx *wire.MsgTx, utxos []*lnwallet.Utxo) {
+	var vsize int64
+	if tx.HasWitness() {
+		weight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
+		vsize = (weight + blockchain.WitnessScaleFactor - 1) / blockchain.WitnessScaleFactor
+	} else {
+		var estimator input.TxWeightEstimator
+		for _, utxo := range utxos {
+			if utxo.AddressType == lnwallet.NestedWitnessPubKey {
+				estimator.AddNestedP2WKHInput()
+			} else {
+				estimator.AddP2WKHInput()
+			}
+		}
+		for _, txOut := range tx.TxOut {
+			estimator.AddTxOutput(txOut)
+		}
+		vsize = int64(estimator.VSize())
+	}
+	details.Vsize = vsize
+	if vsize > 0 {
+		details.SatPerVbyte = float64(details.Fees) / float64(vsize)
+	}
+}
+
+// sweepPsbt returns the serialized PSBT of the unsigned tx spending utxos.
+func sweepPsbt(tx *wire.MsgTx, utxos []*lnwallet.Utxo) ([]byte, error) {
+	packet, err := psbt.NewFromUnsignedTx(tx)
+	if err != nil {
+		return nil, fmt.Errorf("psbt.NewFromUnsignedTx: %w", err)
+	}
+	prevOuts := make(map[wire.OutPoint]*lnwallet.Utxo, len(utxos))
+	for _, utxo := range utxos {
+		prevOuts[utxo.OutPoint] = utxo
+	}
+	for i, txIn := range tx.TxIn {
+		utxo, ok := prevOuts[txIn.PreviousOutPoint]
+		if !ok {
+			return nil, fmt.Errorf("unknown input %v", txIn.PreviousOutPoint)
+		}
+		packet.Inputs[i].WitnessUtxo = wire.NewTxOut(int64(utxo.Value), utxo.PkScript)
+		packet.Inputs[i].SighashType = txscript.SigHashAll
+	}
+	var b bytes.Buffer
+	if err := packet.Serialize(&b); err != nil {
+		return nil, fmt.Errorf("packet.Serialize: %w", err)
+	}
+	return b.Bytes(), nil
+}
+
+// nopSigner doesn't sign anything. It is used to craft unsigned transactions.
+type nopSigner struct{}
+
+func (s *nopSigner) SignOutputRaw(tx *wire.MsgTx, signDesc *input.SignDescriptor) (input.Signature, error) {
+	return nil, errors.New("signing is not supported")
+}
+
+func (s *nopSigner) ComputeInputScript(tx *wire.MsgTx, signDesc *input.SignDescriptor) (*input.Script, error) {
+	return &input.Script{}, nil
 }
 
 type coinSelectionLocker struct{}
@@ -155,17 +588,51 @@ var nilOutpointLocker outpointLocker
 type rpcUtxoSource struct {
 	lightningClient lnrpc.LightningClient
 	totalAmount     int64
+
+	// utxos, if set, are returned instead of the wallet unspent outputs.
+	utxos []*lnwallet.Utxo
+
+	// outpoints, if set, limits the wallet unspent outputs returned.
+	outpoints map[wire.OutPoint]struct{}
+
+	// listed holds the outputs returned by the last ListUnspentWitness call.
+	listed []*lnwallet.Utxo
+
+	// dustLimit, if set, excludes the wallet unspent outputs below it. The
+	// excluded outputs are held in dust.
+	dustLimit btcutil.Amount
+	dust      []*lnwallet.Utxo
+
+	// minConfs overrides the minConfs passed to ListUnspentWitness since
+	// sweep.CraftSweepAllTx always asks for confirmed outputs.
+	minConfs int32
 }
 
 func NewRpcUtxoSource(c lnrpc.LightningClient) *rpcUtxoSource {
 	return &rpcUtxoSource{
 		lightningClient: c,
+		minConfs:        1,
+	}
+}
+
+// newFixedUtxoSource returns a utxo source that only returns utxos.
+func newFixedUtxoSource(utxos []*lnwallet.Utxo) *rpcUtxoSource {
+	return &rpcUtxoSource{
+		utxos: utxos,
 	}
 }
 
 func (u *rpcUtxoSource) ListUnspentWitness(minConfs, maxConfs int32) ([]*lnwallet.Utxo, error) {
+	if u.utxos != nil {
+		u.totalAmount = 0
+		for _, utxo := range u.utxos {
+			u.totalAmount += int64(utxo.Value)
+		}
+		u.listed = u.utxos
+		return u.utxos, nil
+	}
 	utxoOutputs, err := u.lightningClient.ListUnspent(context.Background(), &lnrpc.ListUnspentRequest{
-		MinConfs: 1, MaxConfs: math.MaxInt32,
+		MinConfs: u.minConfs, MaxConfs: math.MaxInt32,
 	})
 	if err != nil {
 		//a.log.Errorf("u.lightningClient.ListUnspent: %v", err)
@@ -173,7 +640,11 @@ func (u *rpcUtxoSource) ListUnspentWitness(minConfs, maxConfs int32) ([]*lnwalle
 	}
 	lu := make([]*lnwallet.Utxo, 0, len(utxoOutputs.Utxos))
 	u.totalAmount = 0
+	u.dust = nil
 	for _, utxo := range utxoOutputs.Utxos {
+		if u.outpoints != nil && !u.isSelected(utxo.Outpoint) {
+			continue
+		}
 
 		var addrType lnwallet.AddressType
 		switch utxo.AddressType {
@@ -183,8 +654,12 @@ func (u *rpcUtxoSource) ListUnspentWitness(minConfs, maxConfs int32) ([]*lnwalle
 		case lnrpc.AddressType_NESTED_PUBKEY_HASH:
 			addrType = lnwallet.NestedWitnessPubKey
 
+		// Taproot outputs can't be swept until we move to an lnd version
+		// that supports them (0.15+); the lnd we embed has no
+		// TAPROOT_PUBKEY address type and can't decode bech32m addresses.
 		default:
-			return nil, fmt.Errorf("invalid utxo address type")
+			return nil, fmt.Errorf("unsupported address type %v of utxo %v:%v",
+				utxo.AddressType, utxo.Outpoint.TxidStr, utxo.Outpoint.OutputIndex)
 		}
 
 		pkScript, err := hex.DecodeString(utxo.PkScript)
@@ -198,7 +673,7 @@ func (u *rpcUtxoSource) ListUnspentWitness(minConfs, maxConfs int32) ([]*lnwalle
 			return nil, fmt.Errorf("outPointHash.SetBytes(%x): %w", utxo.Outpoint.TxidBytes, err)
 		}
 
-		lu = append(lu, &lnwallet.Utxo{
+		walletUtxo := &lnwallet.Utxo{
 			AddressType:   addrType,
 			Value:         btcutil.Amount(utxo.AmountSat),
 			Confirmations: utxo.Confirmations,
@@ -207,12 +682,199 @@ func (u *rpcUtxoSource) ListUnspentWitness(minConfs, maxConfs int32) ([]*lnwalle
 				Hash:  outPointHash,
 				Index: utxo.Outpoint.OutputIndex,
 			},
-		})
+		}
+		if walletUtxo.Value < u.dustLimit {
+			u.dust = append(u.dust, walletUtxo)
+			continue
+		}
+		lu = append(lu, walletUtxo)
 		u.totalAmount += utxo.AmountSat
 	}
+	if u.outpoints != nil && len(lu) != len(u.outpoints) {
+		return nil, errors.New("some of the selected outpoints are not spendable")
+	}
+	u.listed = lu
 	return lu, nil
 }
 
+// outputDustLimit returns the dust limit of the outputs crafted using u.
+func (u *rpcUtxoSource) outputDustLimit() btcutil.Amount {
+	if u.dustLimit > 0 {
+		return u.dustLimit
+	}
+	return lnwallet.DefaultDustLimit()
+}
+
+func (u *rpcUtxoSource) isSelected(outpoint *lnrpc.OutPoint) bool {
+	hash, err := chainhash.NewHashFromStr(outpoint.TxidStr)
+	if err != nil {
+		return false
+	}
+	_, ok := u.outpoints[wire.OutPoint{Hash: *hash, Index: outpoint.OutputIndex}]
+	return ok
+}
+
+func decodeOutPoints(outpoints []*data.OutPoint) (map[wire.OutPoint]struct{}, error) {
+	decoded := make(map[wire.OutPoint]struct{})
+	for _, o := range outpoints {
+		hash, err := chainhash.NewHashFromStr(o.Txid)
+		if err != nil {
+			return nil, fmt.Errorf("invalid txid %v: %w", o.Txid, err)
+		}
+		decoded[wire.OutPoint{Hash: *hash, Index: o.OutputIndex}] = struct{}{}
+	}
+	return decoded, nil
+}
+
+// anchorChanReservedValue is the amount kept in the wallet for each anchor
+// channel so its commitment can be fee bumped when force closed.
+const anchorChanReservedValue = btcutil.Amount(10_000)
+
+// ListUTXOs returns the wallet unspent outputs, including the unconfirmed
+// ones. Outputs needed to cover the anchor channels reserve are marked as
+// anchor reserved.
+func (a *Service) ListUTXOs() (*data.UTXOs, error) {
+	lnClient := a.daemonAPI.APIClient()
+	if lnClient == nil {
+		return nil, errors.New("daemon is not ready")
+	}
+	unspent, err := lnClient.ListUnspent(context.Background(), &lnrpc.ListUnspentRequest{
+		MinConfs: 0, MaxConfs: math.MaxInt32,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("lnClient.ListUnspent: %w", err)
+	}
+	reserve, err := a.anchorReserve()
+	if err != nil {
+		return nil, err
+	}
+	reserved := anchorReservedUtxos(unspent.Utxos, reserve)
+
+	utxos := make([]*data.UTXO, 0, len(unspent.Utxos))
+	for _, utxo := range unspent.Utxos {
+		utxos = append(utxos, &data.UTXO{
+			Outpoint: &data.OutPoint{
+				Txid:        utxo.Outpoint.TxidStr,
+				OutputIndex: utxo.Outpoint.OutputIndex,
+			},
+			Address:        utxo.Address,
+			Amount:         utxo.AmountSat,
+			Confirmations:  utxo.Confirmations,
+			AddressType:    utxoAddressType(utxo.AddressType),
+			AnchorReserved: reserved[utxo],
+		})
+	}
+	return &data.UTXOs{Utxos: utxos}, nil
+}
+
+// anchorReserve returns the amount the wallet keeps for its anchor channels.
+// Like lnd, the pending channels are counted as well since they may still need
+// their commitment to be fee bumped.
+func (a *Service) anchorReserve() (btcutil.Amount, error) {
+	lnClient := a.daemonAPI.APIClient()
+	channels, err := lnClient.ListChannels(context.Background(), &lnrpc.ListChannelsRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("lnClient.ListChannels: %w", err)
+	}
+	var reserve btcutil.Amount
+	for _, c := range channels.Channels {
+		if c.CommitmentType == lnrpc.CommitmentType_ANCHORS {
+			reserve += anchorChanReservedValue
+		}
+	}
+	pendingChannels, err := lnClient.PendingChannels(context.Background(), &lnrpc.PendingChannelsRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("lnClient.PendingChannels: %w", err)
+	}
+	var pending []*lnrpc.PendingChannelsResponse_PendingChannel
+	for _, c := range pendingChannels.PendingOpenChannels {
+		pending = append(pending, c.Channel)
+	}
+	for _, c := range pendingChannels.WaitingCloseChannels {
+		pending = append(pending, c.Channel)
+	}
+	for _, c := range pendingChannels.PendingClosingChannels {
+		pending = append(pending, c.Channel)
+	}
+	for _, c := range pendingChannels.PendingForceClosingChannels {
+		pending = append(pending, c.Channel)
+	}
+	for _, c := range pending {
+		if c.CommitmentType == lnrpc.CommitmentType_ANCHORS {
+			reserve += anchorChanReservedValue
+		}
+	}
+	return reserve, nil
+}
+
+// anchorReserveToKeep returns the part of the anchor reserve that has to stay
+// in the wallet out of the spent coins, since the confirmed coins left unspent
+// don't cover it.
+func (a *Service) anchorReserveToKeep(spent []*lnwallet.Utxo) (btcutil.Amount, error) {
+	reserve, err := a.anchorReserve()
+	if err != nil || reserve == 0 {
+		return 0, err
+	}
+	unspent, err := a.daemonAPI.APIClient().ListUnspent(context.Background(), &lnrpc.ListUnspentRequest{
+		MinConfs: 1, MaxConfs: math.MaxInt32,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("lnClient.ListUnspent: %w", err)
+	}
+	spentOutPoints := make(map[string]struct{}, len(spent))
+	for _, utxo := range spent {
+		spentOutPoints[utxo.OutPoint.String()] = struct{}{}
+	}
+	var left btcutil.Amount
+	for _, utxo := range unspent.Utxos {
+		outPoint := fmt.Sprintf("%v:%v", utxo.Outpoint.TxidStr, utxo.Outpoint.OutputIndex)
+		if _, ok := spentOutPoints[outPoint]; !ok {
+			left += btcutil.Amount(utxo.AmountSat)
+		}
+	}
+	if left >= reserve {
+		return 0, nil
+	}
+	return reserve - left, nil
+}
+
+// anchorReservedUtxos returns the smallest confirmed utxos covering reserve.
+func anchorReservedUtxos(utxos []*lnrpc.Utxo, reserve btcutil.Amount) map[*lnrpc.Utxo]bool {
+	reserved := make(map[*lnrpc.Utxo]bool)
+	if reserve == 0 {
+		return reserved
+	}
+	var confirmed []*lnrpc.Utxo
+	for _, utxo := range utxos {
+		if utxo.Confirmations > 0 {
+			confirmed = append(confirmed, utxo)
+		}
+	}
+	sort.Slice(confirmed, func(i, j int) bool {
+		return confirmed[i].AmountSat < confirmed[j].AmountSat
+	})
+	var total btcutil.Amount
+	for _, utxo := range confirmed {
+		if total >= reserve {
+			break
+		}
+		reserved[utxo] = true
+		total += btcutil.Amount(utxo.AmountSat)
+	}
+	return reserved
+}
+
+func utxoAddressType(addressType lnrpc.AddressType) string {
+	switch addressType {
+	case lnrpc.AddressType_WITNESS_PUBKEY_HASH, lnrpc.AddressType_UNUSED_WITNESS_PUBKEY_HASH:
+		return AddressTypeP2WKH
+	case lnrpc.AddressType_NESTED_PUBKEY_HASH, lnrpc.AddressType_UNUSED_NESTED_PUBKEY_HASH:
+		return AddressTypeNP2WKH
+	default:
+		return "unknown"
+	}
+}
+
 type rpcSigner struct {
 	signerClient signrpc.SignerClient
 }
@@ -0,0 +1,82 @@
+package account
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+func TestAnchorReserve(t *testing.T) {
+	a, api := newDaemonTestService(t)
+	api.Lightning.Channels = []*lnrpc.Channel{
+		{Active: true, CommitmentType: lnrpc.CommitmentType_ANCHORS},
+		{Active: true, CommitmentType: lnrpc.CommitmentType_STATIC_REMOTE_KEY},
+	}
+	api.Lightning.Pending = &lnrpc.PendingChannelsResponse{
+		PendingOpenChannels: []*lnrpc.PendingChannelsResponse_PendingOpenChannel{{
+			Channel: &lnrpc.PendingChannelsResponse_PendingChannel{
+				CommitmentType: lnrpc.CommitmentType_ANCHORS,
+			},
+		}},
+		PendingForceClosingChannels: []*lnrpc.PendingChannelsResponse_ForceClosedChannel{{
+			Channel: &lnrpc.PendingChannelsResponse_PendingChannel{
+				CommitmentType: lnrpc.CommitmentType_LEGACY,
+			},
+		}},
+	}
+
+	reserve, err := a.anchorReserve()
+	if err != nil {
+		t.Fatalf("anchorReserve: %v", err)
+	}
+	if reserve != 2*anchorChanReservedValue {
+		t.Fatalf("expected a reserve of %v, got %v", btcutil.Amount(2*anchorChanReservedValue), reserve)
+	}
+}
+
+func TestAnchorReserveToKeep(t *testing.T) {
+	a, api := newDaemonTestService(t)
+	api.Lightning.Channels = []*lnrpc.Channel{
+		{Active: true, CommitmentType: lnrpc.CommitmentType_ANCHORS},
+		{Active: true, CommitmentType: lnrpc.CommitmentType_ANCHORS},
+	}
+	api.Lightning.Pending = &lnrpc.PendingChannelsResponse{}
+	hash := chainhash.Hash{1}
+	api.Lightning.Utxos = []*lnrpc.Utxo{
+		{AmountSat: 50_000, Confirmations: 3, Outpoint: &lnrpc.OutPoint{TxidStr: hash.String(), OutputIndex: 0}},
+		{AmountSat: 8_000, Confirmations: 3, Outpoint: &lnrpc.OutPoint{TxidStr: hash.String(), OutputIndex: 1}},
+		{AmountSat: 30_000, Confirmations: 0, Outpoint: &lnrpc.OutPoint{TxidStr: hash.String(), OutputIndex: 2}},
+	}
+	spent := func(indexes ...uint32) []*lnwallet.Utxo {
+		var utxos []*lnwallet.Utxo
+		for _, i := range indexes {
+			utxos = append(utxos, &lnwallet.Utxo{OutPoint: wire.OutPoint{Hash: hash, Index: i}})
+		}
+		return utxos
+	}
+
+	tests := []struct {
+		spent []*lnwallet.Utxo
+		keep  btcutil.Amount
+	}{
+		// All the confirmed coins are spent.
+		{spent(0, 1), 2 * anchorChanReservedValue},
+		// The unspent coin covers part of the reserve.
+		{spent(0), 2*anchorChanReservedValue - 8_000},
+		// The unspent coin covers all the reserve.
+		{spent(1), 0},
+	}
+	for _, test := range tests {
+		keep, err := a.anchorReserveToKeep(test.spent)
+		if err != nil {
+			t.Fatalf("anchorReserveToKeep: %v", err)
+		}
+		if keep != test.keep {
+			t.Fatalf("anchorReserveToKeep(%v utxos) = %v want %v", len(test.spent), keep, test.keep)
+		}
+	}
+}
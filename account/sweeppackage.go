@@ -0,0 +1,192 @@
+package account
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
+)
+
+const (
+	// sweepPackageTTL is how long the packages of the published sweeps are
+	// kept for fee bumps.
+	sweepPackageTTL = 14 * 24 * time.Hour
+
+	// craftedSweepTTL is how long the packages of the crafted sweeps are
+	// kept in memory waiting for the sweeps to be published.
+	craftedSweepTTL = time.Hour
+)
+
+// keepSweepPackage keeps what is needed to re-sign the sweep tx spending
+// coins with another fee: its inputs, its outputs and the output to
+// targetAddr which pays the fee. The package is persisted only if the sweep is
+// published.
+func (a *Service) keepSweepPackage(tx *wire.MsgTx, coins []chanfunding.Coin, targetAddr btcutil.Address) error {
+	targetScript, err := txscript.PayToAddrScript(targetAddr)
+	if err != nil {
+		return fmt.Errorf("txscript.PayToAddrScript(%v): %w", targetAddr, err)
+	}
+	pkg := &data.SweepPackage{
+		LockTime: tx.LockTime,
+		Created:  time.Now().Unix(),
+	}
+	for _, coin := range coins {
+		pkg.Inputs = append(pkg.Inputs, &data.SweepPackageInput{
+			Outpoint: &data.OutPoint{
+				Txid:        coin.OutPoint.Hash.String(),
+				OutputIndex: coin.OutPoint.Index,
+			},
+			Amount:   coin.Value,
+			PkScript: coin.PkScript,
+		})
+	}
+	changeIndex := -1
+	for i, txOut := range tx.TxOut {
+		if changeIndex < 0 && bytes.Equal(txOut.PkScript, targetScript) {
+			changeIndex = i
+		}
+		pkg.Outputs = append(pkg.Outputs, &data.SweepPackageOutput{
+			Amount:   txOut.Value,
+			PkScript: txOut.PkScript,
+		})
+	}
+	if changeIndex < 0 {
+		return fmt.Errorf("no output to %v", targetAddr)
+	}
+	pkg.ChangeIndex = uint32(changeIndex)
+	a.keepCraftedSweep(tx.TxHash().String(), pkg)
+	return nil
+}
+
+// keepCraftedSweep keeps the package of the crafted sweep txid until it is
+// published, forgetting the sweeps crafted more than craftedSweepTTL ago.
+func (a *Service) keepCraftedSweep(txid string, pkg *data.SweepPackage) {
+	a.craftedSweepsMu.Lock()
+	defer a.craftedSweepsMu.Unlock()
+	if a.craftedSweeps == nil {
+		a.craftedSweeps = make(map[string]*data.SweepPackage)
+	}
+	expired := time.Now().Add(-craftedSweepTTL).Unix()
+	for k, p := range a.craftedSweeps {
+		if p.Created < expired {
+			delete(a.craftedSweeps, k)
+		}
+	}
+	a.craftedSweeps[txid] = pkg
+}
+
+// saveCraftedSweep persists the package of the sweep txid, if it was crafted
+// by us, once it is published.
+func (a *Service) saveCraftedSweep(txid string) error {
+	a.craftedSweepsMu.Lock()
+	pkg, ok := a.craftedSweeps[txid]
+	delete(a.craftedSweeps, txid)
+	a.craftedSweepsMu.Unlock()
+	if !ok {
+		return nil
+	}
+	prunedBefore := time.Now().Add(-sweepPackageTTL).Unix()
+	return a.breezDB.SaveSweepPackage(txid, pkg, prunedBefore)
+}
+
+// resignSweepPackage rebuilds the sweep of pkg paying feePerKw by only
+// adjusting its change output, and signs it.
+func (a *Service) resignSweepPackage(pkg *data.SweepPackage, feePerKw chainfee.SatPerKWeight) (
+	*data.TransactionDetails, int64, error) {
+
+	tx := wire.NewMsgTx(2)
+	tx.LockTime = pkg.LockTime
+	var estimator input.TxWeightEstimator
+	coins := make([]chanfunding.Coin, 0, len(pkg.Inputs))
+	var totalIn int64
+	for _, in := range pkg.Inputs {
+		hash, err := chainhash.NewHashFromStr(in.Outpoint.Txid)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid txid %v: %w", in.Outpoint.Txid, err)
+		}
+		outPoint := wire.OutPoint{Hash: *hash, Index: in.Outpoint.OutputIndex}
+		tx.AddTxIn(&wire.TxIn{PreviousOutPoint: outPoint, Sequence: a.txInSequence()})
+		coins = append(coins, chanfunding.Coin{
+			TxOut:    wire.TxOut{Value: in.Amount, PkScript: in.PkScript},
+			OutPoint: outPoint,
+		})
+		if txscript.IsPayToScriptHash(in.PkScript) {
+			estimator.AddNestedP2WKHInput()
+		} else {
+			estimator.AddP2WKHInput()
+		}
+		totalIn += in.Amount
+	}
+
+	var fixedOut int64
+	for i, out := range pkg.Outputs {
+		txOut := wire.NewTxOut(out.Amount, out.PkScript)
+		tx.AddTxOut(txOut)
+		estimator.AddTxOutput(txOut)
+		if uint32(i) != pkg.ChangeIndex {
+			fixedOut += out.Amount
+		}
+	}
+	if int(pkg.ChangeIndex) >= len(tx.TxOut) {
+		return nil, 0, fmt.Errorf("invalid change index %v", pkg.ChangeIndex)
+	}
+
+	fee := int64(feePerKw.FeeForWeight(int64(estimator.Weight())))
+	change := totalIn - fixedOut - fee
+	if btcutil.Amount(change) < a.sweepDustLimit() {
+		return nil, 0, fmt.Errorf("the fee %v leaves a change below the dust limit", fee)
+	}
+	tx.TxOut[pkg.ChangeIndex].Value = change
+
+	if err := signWalletInputs(tx, coins, NewRpcSigner(a.daemonAPI.SignerClient())); err != nil {
+		return nil, 0, err
+	}
+	var rawTx bytes.Buffer
+	if err := tx.Serialize(&rawTx); err != nil {
+		return nil, 0, fmt.Errorf("tx.Serialize %#v: %w", tx, err)
+	}
+
+	// Keep the package of the replacement for the next fee bump.
+	bumped := &data.SweepPackage{
+		Inputs:      pkg.Inputs,
+		ChangeIndex: pkg.ChangeIndex,
+		LockTime:    pkg.LockTime,
+		Created:     time.Now().Unix(),
+	}
+	for _, txOut := range tx.TxOut {
+		bumped.Outputs = append(bumped.Outputs, &data.SweepPackageOutput{
+			Amount:   txOut.Value,
+			PkScript: txOut.PkScript,
+		})
+	}
+	a.keepCraftedSweep(tx.TxHash().String(), bumped)
+
+	details := &data.TransactionDetails{
+		Tx:          rawTx.Bytes(),
+		TxHash:      tx.TxHash().String(),
+		Fees:        fee,
+		Replaceable: isReplaceable(tx),
+	}
+	setTxSize(details, tx, nil)
+	return details, totalIn, nil
+}
+
+// sweepPackageFee returns the fee paid by the sweep of pkg.
+func sweepPackageFee(pkg *data.SweepPackage) int64 {
+	var fee int64
+	for _, in := range pkg.Inputs {
+		fee += in.Amount
+	}
+	for _, out := range pkg.Outputs {
+		fee -= out.Amount
+	}
+	return fee
+}
@@ -0,0 +1,235 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/breez/breez/data"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	timelockedFundsClaimLabel = "timelocked funds claim"
+)
+
+// timelockedWitnessTypes are the witness types of the outputs that are time
+// locked after our own force close.
+var timelockedWitnessTypes = map[walletrpc.WitnessType]struct{}{
+	walletrpc.WitnessType_COMMITMENT_TIME_LOCK:               {},
+	walletrpc.WitnessType_HTLC_OFFERED_TIMEOUT_SECOND_LEVEL:  {},
+	walletrpc.WitnessType_HTLC_ACCEPTED_SUCCESS_SECOND_LEVEL: {},
+}
+
+// TimelockedFunds returns the channels we force closed that still have funds
+// locked by the CSV delay.
+func (a *Service) TimelockedFunds() (*data.TimelockedFunds, error) {
+	lnClient := a.daemonAPI.APIClient()
+	if lnClient == nil {
+		return nil, errors.New("daemon is not ready")
+	}
+	pending, err := lnClient.PendingChannels(context.Background(), &lnrpc.PendingChannelsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("lnClient.PendingChannels: %w", err)
+	}
+	var channels []*data.TimelockedChannel
+	for _, c := range pending.PendingForceClosingChannels {
+		if c.LimboBalance == 0 {
+			continue
+		}
+		channels = append(channels, &data.TimelockedChannel{
+			ChannelPoint:      c.Channel.ChannelPoint,
+			ClosingTxid:       c.ClosingTxid,
+			LimboBalance:      c.LimboBalance,
+			MaturityHeight:    c.MaturityHeight,
+			BlocksTilMaturity: c.BlocksTilMaturity,
+		})
+	}
+	return &data.TimelockedFunds{Channels: channels}, nil
+}
+
+/*
+ClaimTimelockedFunds claims the outputs of our force closes whose CSV delay
+expired at a fee rate of satPerVbyte. The outputs are claimed to the wallet
+by the lnd sweeper and, if address isn't empty, forwarded to address once
+the claim transaction is seen.
+*/
+func (a *Service) ClaimTimelockedFunds(address string, satPerVbyte int64) (*data.TimelockedFundsClaim, error) {
+	if address != "" {
+		if _, err := a.sweepTargetAddress(address); err != nil {
+			return nil, err
+		}
+	}
+	feePerKw := chainfee.SatPerKVByte(satPerVbyte * 1000).FeePerKWeight()
+	if feePerKw < chainfee.FeePerKwFloor {
+		return nil, fmt.Errorf("fee rate of %v sat/vbyte is too low", satPerVbyte)
+	}
+	walletKitClient := a.daemonAPI.WalletKitClient()
+	if walletKitClient == nil {
+		return nil, errors.New("daemon is not ready")
+	}
+
+	// The nursery hands the outputs to the sweeper once they mature.
+	sweeps, err := walletKitClient.PendingSweeps(context.Background(), &walletrpc.PendingSweepsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("walletKitClient.PendingSweeps: %w", err)
+	}
+	claim := &data.TimelockedFundsClaim{Address: address, SatPerVbyte: satPerVbyte}
+	for _, s := range sweeps.PendingSweeps {
+		if _, ok := timelockedWitnessTypes[s.WitnessType]; !ok {
+			continue
+		}
+		_, err := walletKitClient.BumpFee(context.Background(), &walletrpc.BumpFeeRequest{
+			Outpoint:   s.Outpoint,
+			SatPerByte: uint32(satPerVbyte),
+			Force:      true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("walletKitClient.BumpFee: %w", err)
+		}
+		hash, err := chainhash.NewHash(s.Outpoint.TxidBytes)
+		if err != nil {
+			return nil, fmt.Errorf("chainhash.NewHash(%x): %w", s.Outpoint.TxidBytes, err)
+		}
+		claim.Outpoints = append(claim.Outpoints, &data.OutPoint{
+			Txid:        hash.String(),
+			OutputIndex: s.Outpoint.OutputIndex,
+		})
+	}
+	if len(claim.Outpoints) == 0 {
+		return nil, errors.New("no timelocked funds to claim")
+	}
+	a.log.Infof("ClaimTimelockedFunds: claiming %v outputs at %v sat/vbyte", len(claim.Outpoints), satPerVbyte)
+
+	// The outputs claimed again to the wallet are no longer forwarded.
+	if address == "" {
+		err = a.breezDB.DeleteTimelockedFundsClaims(claim.Outpoints)
+	} else {
+		err = a.breezDB.AddTimelockedFundsClaim(claim)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return claim, nil
+}
+
+// forwardTimelockedFundsClaim sends the wallet outputs of the transactions
+// claiming timelocked funds to the claim addresses.
+func (a *Service) forwardTimelockedFundsClaim() {
+	claims, err := a.breezDB.FetchTimelockedFundsClaims()
+	if err != nil {
+		a.log.Errorf("breezDB.FetchTimelockedFundsClaims: %v", err)
+		return
+	}
+
+	// The outputs forwarded to the same address at the same fee rate are
+	// forwarded together.
+	var grouped []*data.TimelockedFundsClaim
+	byTarget := make(map[string]*data.TimelockedFundsClaim)
+	for _, c := range claims {
+		target := fmt.Sprintf("%v:%v", c.Address, c.SatPerVbyte)
+		group, ok := byTarget[target]
+		if !ok {
+			group = &data.TimelockedFundsClaim{Address: c.Address, SatPerVbyte: c.SatPerVbyte}
+			byTarget[target] = group
+			grouped = append(grouped, group)
+		}
+		group.Outpoints = append(group.Outpoints, c.Outpoints...)
+	}
+	for _, claim := range grouped {
+		if err := a.forwardClaim(claim); err != nil {
+			a.log.Errorf("forwardTimelockedFundsClaim: %v", err)
+		}
+	}
+}
+
+func (a *Service) forwardClaim(claim *data.TimelockedFundsClaim) error {
+	claimed, err := decodeOutPoints(claim.Outpoints)
+	if err != nil {
+		return err
+	}
+	lnClient := a.daemonAPI.APIClient()
+	txs, err := lnClient.GetTransactions(context.Background(), &lnrpc.GetTransactionsRequest{})
+	if err != nil {
+		return fmt.Errorf("lnClient.GetTransactions: %w", err)
+	}
+
+	// Find the sweeper transactions spending the claimed outputs.
+	var claimTxs []*wire.MsgTx
+	for _, walletTx := range txs.Transactions {
+		tx, err := decodeWalletTx(walletTx)
+		if err != nil {
+			continue
+		}
+		spends := false
+		for _, txIn := range tx.TxIn {
+			if _, ok := claimed[txIn.PreviousOutPoint]; ok {
+				delete(claimed, txIn.PreviousOutPoint)
+				spends = true
+			}
+		}
+		if spends {
+			claimTxs = append(claimTxs, tx)
+		}
+	}
+	if len(claimTxs) == 0 {
+		return nil
+	}
+
+	unspent, err := lnClient.ListUnspent(context.Background(), &lnrpc.ListUnspentRequest{
+		MinConfs: 0, MaxConfs: math.MaxInt32,
+	})
+	if err != nil {
+		return fmt.Errorf("lnClient.ListUnspent: %w", err)
+	}
+	outpoints := make(map[wire.OutPoint]struct{})
+	for _, tx := range claimTxs {
+		txid := tx.TxHash()
+		for _, utxo := range unspent.Utxos {
+			if utxo.Outpoint.TxidStr == txid.String() {
+				outpoints[wire.OutPoint{Hash: txid, Index: utxo.Outpoint.OutputIndex}] = struct{}{}
+			}
+		}
+	}
+
+	if len(outpoints) > 0 {
+		targetAddr, err := a.sweepTargetAddress(claim.Address)
+		if err != nil {
+			return err
+		}
+		info, err := lnClient.GetInfo(context.Background(), &lnrpc.GetInfoRequest{})
+		if err != nil {
+			return fmt.Errorf("lnClient.GetInfo: %w", err)
+		}
+		rus := NewRpcUtxoSource(lnClient)
+		rus.minConfs = 0
+		rus.outpoints = outpoints
+		feePerKw := chainfee.SatPerKVByte(claim.SatPerVbyte * 1000).FeePerKWeight()
+		details, _, err := a.craftSweepAllTx(targetAddr, nil, feePerKw, info.BlockHeight, rus, false)
+		if err != nil {
+			return err
+		}
+		if err := a.PublishTransactionWithLabel(details.Tx, timelockedFundsClaimLabel); err != nil {
+			return err
+		}
+		a.log.Infof("forwardTimelockedFundsClaim: forwarded to %v in %v", claim.Address, details.TxHash)
+	}
+
+	// Keep waiting for the outputs that weren't claimed yet.
+	var forwarded []*data.OutPoint
+	for _, o := range claim.Outpoints {
+		hash, err := chainhash.NewHashFromStr(o.Txid)
+		if err != nil {
+			continue
+		}
+		if _, ok := claimed[wire.OutPoint{Hash: *hash, Index: o.OutputIndex}]; !ok {
+			forwarded = append(forwarded, o)
+		}
+	}
+	return a.breezDB.DeleteTimelockedFundsClaims(forwarded)
+}
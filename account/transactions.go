@@ -0,0 +1,217 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/breez/breez/data"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// ListOnchainTransactions returns the wallet onchain transactions together
+// with the labels the user gave them.
+func (a *Service) ListOnchainTransactions() (*data.OnchainTransactions, error) {
+	lnClient := a.daemonAPI.APIClient()
+	if lnClient == nil {
+		return nil, errors.New("daemon is not ready")
+	}
+	txs, err := lnClient.GetTransactions(context.Background(), &lnrpc.GetTransactionsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("lnClient.GetTransactions: %w", err)
+	}
+	labels, err := a.breezDB.FetchTxLabels()
+	if err != nil {
+		return nil, fmt.Errorf("breezDB.FetchTxLabels: %w", err)
+	}
+
+	transactions := make([]*data.OnchainTransaction, 0, len(txs.Transactions))
+	for _, tx := range txs.Transactions {
+		label, ok := labels[tx.TxHash]
+		if !ok {
+			label = tx.Label
+		}
+		transactions = append(transactions, &data.OnchainTransaction{
+			Txid:             tx.TxHash,
+			Amount:           tx.Amount,
+			Fees:             tx.TotalFees,
+			NumConfirmations: tx.NumConfirmations,
+			BlockHeight:      tx.BlockHeight,
+			TimeStamp:        tx.TimeStamp,
+			Label:            label,
+		})
+	}
+	return &data.OnchainTransactions{Transactions: transactions}, nil
+}
+
+// The onchain history formats supported by ExportOnchainHistory.
+const (
+	HistoryFormatCSV  = "csv"
+	HistoryFormatJSON = "json"
+)
+
+// The classification of the onchain history entries.
+const (
+	onchainTxChannelOpen  = "channel_open"
+	onchainTxChannelClose = "channel_close"
+	onchainTxSwap         = "swap"
+	onchainTxSweep        = "sweep"
+	onchainTxDeposit      = "deposit"
+)
+
+type onchainHistoryEntry struct {
+	Txid          string `json:"txid"`
+	Timestamp     int64  `json:"timestamp"`
+	Amount        int64  `json:"amount"`
+	Fees          int64  `json:"fees"`
+	BlockHeight   int32  `json:"block_height"`
+	Confirmations int32  `json:"confirmations"`
+	Label         string `json:"label"`
+	Type          string `json:"type"`
+}
+
+/*
+ExportOnchainHistory exports all the wallet onchain transactions in format,
+csv or json, for bookkeeping. Every transaction is classified as a channel
+open or close, a swap, a sweep of wallet coins or a deposit.
+*/
+func (a *Service) ExportOnchainHistory(format string) ([]byte, error) {
+	if format != HistoryFormatCSV && format != HistoryFormatJSON {
+		return nil, fmt.Errorf("unknown format %v", format)
+	}
+	lnClient := a.daemonAPI.APIClient()
+	if lnClient == nil {
+		return nil, errors.New("daemon is not ready")
+	}
+	txs, err := lnClient.GetTransactions(context.Background(), &lnrpc.GetTransactionsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("lnClient.GetTransactions: %w", err)
+	}
+	labels, err := a.breezDB.FetchTxLabels()
+	if err != nil {
+		return nil, fmt.Errorf("breezDB.FetchTxLabels: %w", err)
+	}
+	classify, err := a.onchainTxClassifier()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]onchainHistoryEntry, 0, len(txs.Transactions))
+	for _, tx := range txs.Transactions {
+		label, ok := labels[tx.TxHash]
+		if !ok {
+			label = tx.Label
+		}
+		entries = append(entries, onchainHistoryEntry{
+			Txid:          tx.TxHash,
+			Timestamp:     tx.TimeStamp,
+			Amount:        tx.Amount,
+			Fees:          tx.TotalFees,
+			BlockHeight:   tx.BlockHeight,
+			Confirmations: tx.NumConfirmations,
+			Label:         label,
+			Type:          classify(tx),
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Timestamp < entries[j].Timestamp
+	})
+
+	if format == HistoryFormatJSON {
+		return json.Marshal(entries)
+	}
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"txid", "timestamp", "amount", "fees", "block_height", "confirmations", "label", "type"})
+	for _, e := range entries {
+		w.Write([]string{
+			e.Txid,
+			strconv.FormatInt(e.Timestamp, 10),
+			strconv.FormatInt(e.Amount, 10),
+			strconv.FormatInt(e.Fees, 10),
+			strconv.FormatInt(int64(e.BlockHeight), 10),
+			strconv.FormatInt(int64(e.Confirmations), 10),
+			e.Label,
+			e.Type,
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("csv.Write: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// onchainTxClassifier returns a function classifying the wallet transactions
+// using the channels and the swaps of the wallet.
+func (a *Service) onchainTxClassifier() (func(tx *lnrpc.Transaction) string, error) {
+	lnClient := a.daemonAPI.APIClient()
+	types := make(map[string]string)
+
+	channels, err := lnClient.ListChannels(context.Background(), &lnrpc.ListChannelsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("lnClient.ListChannels: %w", err)
+	}
+	for _, c := range channels.Channels {
+		types[channelPointTxid(c.ChannelPoint)] = onchainTxChannelOpen
+	}
+	closed, err := lnClient.ClosedChannels(context.Background(), &lnrpc.ClosedChannelsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("lnClient.ClosedChannels: %w", err)
+	}
+	for _, c := range closed.Channels {
+		types[channelPointTxid(c.ChannelPoint)] = onchainTxChannelOpen
+		types[c.ClosingTxHash] = onchainTxChannelClose
+	}
+	pending, err := lnClient.PendingChannels(context.Background(), &lnrpc.PendingChannelsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("lnClient.PendingChannels: %w", err)
+	}
+	for _, c := range pending.PendingOpenChannels {
+		types[channelPointTxid(c.Channel.ChannelPoint)] = onchainTxChannelOpen
+	}
+	for _, c := range pending.PendingForceClosingChannels {
+		types[channelPointTxid(c.Channel.ChannelPoint)] = onchainTxChannelOpen
+		types[c.ClosingTxid] = onchainTxChannelClose
+	}
+	for _, c := range pending.WaitingCloseChannels {
+		types[channelPointTxid(c.Channel.ChannelPoint)] = onchainTxChannelOpen
+	}
+
+	swaps, err := a.breezDB.FetchAllSwapAddresses()
+	if err != nil {
+		return nil, fmt.Errorf("breezDB.FetchAllSwapAddresses: %w", err)
+	}
+	swapAddresses := make(map[string]struct{})
+	for _, s := range swaps {
+		swapAddresses[s.Address] = struct{}{}
+		if s.LastRefundTxID != "" {
+			types[s.LastRefundTxID] = onchainTxSwap
+		}
+	}
+
+	return func(tx *lnrpc.Transaction) string {
+		if t, ok := types[tx.TxHash]; ok {
+			return t
+		}
+		for _, address := range tx.DestAddresses {
+			if _, ok := swapAddresses[address]; ok {
+				return onchainTxSwap
+			}
+		}
+		if tx.Amount < 0 {
+			return onchainTxSweep
+		}
+		return onchainTxDeposit
+	}, nil
+}
+
+func channelPointTxid(channelPoint string) string {
+	return strings.SplitN(channelPoint, ":", 2)[0]
+}
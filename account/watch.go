@@ -0,0 +1,152 @@
+package account
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/breez/breez/chainservice"
+	"github.com/breez/breez/data"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/chainrpc"
+)
+
+/*
+WatchAddress registers an address, or an output script, to be watched for a
+transaction paying to it. A WATCHED_ADDRESS_CONFIRMED event is emitted once
+such a transaction has NumConfs confirmations and the address is then no
+longer watched.
+*/
+func (a *Service) WatchAddress(watched *data.WatchedAddress) error {
+	if watched.Address != "" {
+		addr, err := btcutil.DecodeAddress(watched.Address, a.activeParams)
+		if err != nil {
+			return fmt.Errorf("btcutil.DecodeAddress(%v): %w", watched.Address, err)
+		}
+		if watched.Script, err = txscript.PayToAddrScript(addr); err != nil {
+			return fmt.Errorf("txscript.PayToAddrScript(%v): %w", watched.Address, err)
+		}
+	}
+	if len(watched.Script) == 0 {
+		return errors.New("an address or a script is required")
+	}
+	if watched.NumConfs == 0 {
+		watched.NumConfs = 1
+	}
+	if watched.HeightHint == 0 {
+		info, err := a.daemonAPI.APIClient().GetInfo(context.Background(), &lnrpc.GetInfoRequest{})
+		if err != nil {
+			return fmt.Errorf("lnClient.GetInfo: %w", err)
+		}
+		watched.HeightHint = info.BlockHeight
+	}
+
+	key := watchedAddressKey(watched)
+	if err := a.breezDB.SaveWatchedAddress(key, watched); err != nil {
+		return err
+	}
+	return a.subscribeWatchedAddress(key, watched)
+}
+
+// UnwatchAddress stops watching the address, or the output script, of watched.
+func (a *Service) UnwatchAddress(watched *data.WatchedAddress) error {
+	key := watchedAddressKey(watched)
+	a.watchMu.Lock()
+	if cancel, ok := a.watchCancels[key]; ok {
+		cancel()
+		delete(a.watchCancels, key)
+	}
+	a.watchMu.Unlock()
+	return a.breezDB.DeleteWatchedAddress(key)
+}
+
+// WatchedAddresses returns the addresses currently watched.
+func (a *Service) WatchedAddresses() (*data.WatchedAddresses, error) {
+	saved, err := a.breezDB.FetchWatchedAddresses()
+	if err != nil {
+		return nil, err
+	}
+	addresses := make([]*data.WatchedAddress, 0, len(saved))
+	for _, watched := range saved {
+		addresses = append(addresses, watched)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return addresses[i].HeightHint < addresses[j].HeightHint
+	})
+	return &data.WatchedAddresses{Addresses: addresses}, nil
+}
+
+func watchedAddressKey(watched *data.WatchedAddress) string {
+	if watched.Address != "" {
+		return watched.Address
+	}
+	return hex.EncodeToString(watched.Script)
+}
+
+// watchSavedAddresses subscribes to the confirmations of all the watched
+// addresses.
+func (a *Service) watchSavedAddresses() {
+	saved, err := a.breezDB.FetchWatchedAddresses()
+	if err != nil {
+		a.log.Errorf("breezDB.FetchWatchedAddresses: %v", err)
+		return
+	}
+	for key, watched := range saved {
+		if err := a.subscribeWatchedAddress(key, watched); err != nil {
+			a.log.Errorf("subscribeWatchedAddress(%v): %v", key, err)
+		}
+	}
+}
+
+func (a *Service) subscribeWatchedAddress(key string, watched *data.WatchedAddress) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	a.watchMu.Lock()
+	if previous, ok := a.watchCancels[key]; ok {
+		previous()
+	}
+	a.watchCancels[key] = cancel
+	a.watchMu.Unlock()
+
+	confirmed, err := chainservice.WatchScript(ctx, a.daemonAPI, watched.Script, watched.NumConfs, watched.HeightHint)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("chainservice.WatchScript(%x): %w", watched.Script, err)
+	}
+	go func() {
+		select {
+		case conf, ok := <-confirmed:
+			if ok {
+				a.onWatchedAddressConfirmed(key, watched, conf)
+			}
+		case <-a.quitChan:
+		}
+		cancel()
+	}()
+	return nil
+}
+
+func (a *Service) onWatchedAddressConfirmed(key string, watched *data.WatchedAddress, conf *chainrpc.ConfDetails) {
+	var txid string
+	if tx, err := btcutil.NewTxFromBytes(conf.RawTx); err == nil {
+		txid = tx.Hash().String()
+	} else {
+		a.log.Errorf("btcutil.NewTxFromBytes(%x): %v", conf.RawTx, err)
+	}
+	a.log.Infof("watched address %v confirmed in %v at height %v", key, txid, conf.BlockHeight)
+
+	a.watchMu.Lock()
+	delete(a.watchCancels, key)
+	a.watchMu.Unlock()
+	if err := a.breezDB.DeleteWatchedAddress(key); err != nil {
+		a.log.Errorf("breezDB.DeleteWatchedAddress(%v): %v", key, err)
+	}
+	a.onServiceEvent(data.NotificationEvent{
+		Type: data.NotificationEvent_WATCHED_ADDRESS_CONFIRMED,
+		Data: []string{key, txid, strconv.FormatUint(uint64(conf.BlockHeight), 10), watched.Label},
+	})
+}
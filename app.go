@@ -8,7 +8,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"sync/atomic"
+	"time"
 
 	"github.com/breez/breez/bootstrap"
 	"github.com/breez/breez/chainservice"
@@ -24,6 +26,10 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc/breezbackuprpc"
 )
 
+const (
+	testPeerTimeout = 10 * time.Second
+)
+
 //Service is the interface to be implemeted by all breez services
 type Service interface {
 	Start() error
@@ -38,10 +44,12 @@ func (a *App) Start() error {
 		return errors.New("Breez already started")
 	}
 
-	a.log.Info("app.start before bootstrap")
-	if err := chainservice.Bootstrap(a.cfg.WorkingDir); err != nil {
-		a.log.Info("app.start bootstrap error %v", err)
-		return err
+	if a.cfg.BitcoindCfg.RPCHost == "" {
+		a.log.Info("app.start before bootstrap")
+		if err := chainservice.Bootstrap(a.cfg.WorkingDir); err != nil {
+			a.log.Info("app.start bootstrap error %v", err)
+			return err
+		}
 	}
 
 	services := []Service{
@@ -81,7 +89,9 @@ func (a *App) Stop() error {
 	a.SwapService.Stop()
 	a.AccountService.Stop()
 	a.ServicesClient.Stop()
-	a.lnDaemon.Stop()
+	if err := a.lnDaemon.Stop(); err != nil {
+		a.log.Errorf("lnDaemon.Stop: %v", err)
+	}
 	doubleratchet.Stop()
 	a.releaseBreezDB()
 
@@ -116,6 +126,18 @@ func (a *App) RestartDaemon() error {
 	return a.lnDaemon.RestartDaemon()
 }
 
+// PruneChannelDB deletes the failed payments and the invoices settled more
+// than olderThan ago from channel.db while the daemon is stopped.
+func (a *App) PruneChannelDB(olderThan time.Duration) (int, int, error) {
+	return a.lnDaemon.PruneChannelDB(olderThan)
+}
+
+// ResetDaemonCrashLoop allows restarting the daemon after it crashed too many
+// times in a row.
+func (a *App) ResetDaemonCrashLoop() {
+	a.lnDaemon.ResetCrashLoop()
+}
+
 // Restore is the breez API for restoring a specific nodeID using the configured
 // backup backend provider.
 func (a *App) Restore(nodeID string, key []byte) error {
@@ -161,7 +183,7 @@ func (a *App) watchDaemonEvents() error {
 	for {
 		select {
 		case u := <-client.Updates():
-			switch u.(type) {
+			switch update := u.(type) {
 			case lnnode.DaemonReadyEvent:
 				atomic.StoreInt32(&a.isReady, 1)
 				go a.ensureSafeToRunNode()
@@ -169,6 +191,63 @@ func (a *App) watchDaemonEvents() error {
 			case lnnode.DaemonDownEvent:
 				atomic.StoreInt32(&a.isReady, 0)
 				go a.notify(data.NotificationEvent{Type: data.NotificationEvent_LIGHTNING_SERVICE_DOWN})
+			case lnnode.DaemonCrashLoopEvent:
+				go a.notify(data.NotificationEvent{
+					Type: data.NotificationEvent_DAEMON_CRASH_LOOP,
+					Data: []string{strconv.Itoa(update.Crashes), update.LastError.Error()},
+				})
+			case lnnode.ReadinessEvent:
+				go a.notify(data.NotificationEvent{
+					Type: data.NotificationEvent_READINESS_CHANGED,
+					Data: []string{
+						strconv.FormatBool(update.ChainSynced),
+						strconv.Itoa(int(update.ChainSyncProgress)),
+						strconv.FormatBool(update.GraphSynced),
+						strconv.Itoa(int(update.ConnectedPeers)),
+						strconv.Itoa(int(update.ActiveChannels)),
+						strconv.Itoa(int(update.InactiveChannels)),
+						strconv.FormatBool(update.ReadyForPayment),
+					},
+				})
+			case lnnode.StorageQuotaExceededEvent:
+				go a.notify(data.NotificationEvent{
+					Type: data.NotificationEvent_STORAGE_QUOTA_EXCEEDED,
+					Data: []string{strconv.FormatInt(update.Total, 10), strconv.FormatInt(update.Quota, 10)},
+				})
+			case lnnode.ChainSyncProgressEvent:
+				go a.notify(data.NotificationEvent{
+					Type: data.NotificationEvent_CHAIN_SYNC_PROGRESS,
+					Data: []string{
+						strconv.Itoa(int(update.HeaderHeight)),
+						strconv.Itoa(int(update.FilterHeaderHeight)),
+						strconv.Itoa(int(update.TargetHeight)),
+						strconv.Itoa(int(update.Percent)),
+						strconv.Itoa(int(update.Peers)),
+					},
+				})
+			case lnnode.ChainReorgEvent:
+				go a.notify(data.NotificationEvent{
+					Type: data.NotificationEvent_CHAIN_REORG,
+					Data: []string{
+						update.OldTip.Hash.String(),
+						strconv.Itoa(int(update.OldTip.Height)),
+						update.NewTip.Hash.String(),
+						strconv.Itoa(int(update.NewTip.Height)),
+						strconv.Itoa(int(update.Depth)),
+					},
+				})
+			case lnnode.FilterHeaderMismatchEvent:
+				for _, m := range update.Mismatches {
+					go a.notify(data.NotificationEvent{
+						Type: data.NotificationEvent_FILTER_HEADER_MISMATCH,
+						Data: []string{
+							m.Peer,
+							strconv.Itoa(int(m.Height)),
+							m.Local.String(),
+							m.Remote.String(),
+						},
+					})
+				}
 			case lnnode.BackupNeededEvent:
 				a.BackupManager.RequestCommitmentChangedBackup()
 			case lnnode.ChannelEvent:
@@ -176,6 +255,9 @@ func (a *App) watchDaemonEvents() error {
 					go a.ensureSafeToRunNode()
 				}
 			case lnnode.ChainSyncedEvent:
+				if a.cfg.BitcoindCfg.RPCHost != "" {
+					break
+				}
 				chainService, cleanupFn, err := chainservice.Get(a.cfg.WorkingDir, a.breezDB)
 				if err != nil {
 					a.log.Errorf("failed to get chain service on sync event")
@@ -186,6 +268,11 @@ func (a *App) watchDaemonEvents() error {
 					a.log.Errorf("purge compact filters finished error = %v", err)
 				}
 				cleanupFn()
+				go func() {
+					if err := chainservice.UpdateFilterHeaderCheckpoint(a.cfg.WorkingDir, a.breezDB); err != nil {
+						a.log.Errorf("failed to update the filter header checkpoint: %v", err)
+					}
+				}()
 			}
 		case <-client.Quit():
 			return nil
@@ -236,13 +323,54 @@ func (a *App) SetPeers(peers []string) error {
 }
 
 func (a *App) TestPeer(peer string) error {
+	diagnostics, err := a.PeerDiagnostics(peer)
+	if err != nil {
+		return err
+	}
+	if !diagnostics.SupportsFilters {
+		return errors.New("peer doesn't serve compact filters")
+	}
+	return nil
+}
+
+func (a *App) PeerDiagnostics(peer string) (*data.PeerDiagnostics, error) {
 	if peer == "" {
 		if len(a.cfg.JobCfg.ConnectedPeers) == 0 {
-			return errors.New("no default peer")
+			return nil, errors.New("no default peer")
 		}
 		peer = a.cfg.JobCfg.ConnectedPeers[0]
 	}
-	return chainservice.TestPeer(peer)
+	ctx, cancel := context.WithTimeout(context.Background(), testPeerTimeout)
+	defer cancel()
+	d, err := chainservice.TestPeer(ctx, peer, a.cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &data.PeerDiagnostics{
+		Addr:            d.Addr,
+		LatencyMs:       d.Latency.Milliseconds(),
+		ProtocolVersion: d.ProtocolVersion,
+		Services:        uint64(d.Services),
+		UserAgent:       d.UserAgent,
+		BestHeight:      d.BestHeight,
+		SupportsFilters: d.SupportsFilters(),
+	}, nil
+}
+
+func (a *App) PeerBans() (*data.PeerBanList, error) {
+	bans, err := chainservice.PeerBans(a.breezDB)
+	if err != nil {
+		return nil, err
+	}
+	return &data.PeerBanList{Bans: bans}, nil
+}
+
+func (a *App) BanPeer(peer, reason string, duration time.Duration) (*data.PeerBan, error) {
+	return chainservice.BanPeer(a.breezDB, peer, reason, duration)
+}
+
+func (a *App) UnbanPeer(peer string) error {
+	return chainservice.UnbanPeer(a.breezDB, peer)
 }
 
 func (a *App) GetPeers() (peers []string, isDefault bool, err error) {
@@ -261,6 +389,43 @@ func (a *App) ClosedChannels() (int, error) {
 	return a.lnDaemon.ClosedChannels()
 }
 
+func (a *App) HealthCheck() (*data.NodeHealth, error) {
+	return a.lnDaemon.HealthCheck()
+}
+
+func (a *App) NodeStats() *data.NodeStats {
+	uptime, daemonUptime := a.lnDaemon.Uptime()
+	succeeded, failed, avgPathfinding := a.AccountService.PaymentStats()
+	return &data.NodeStats{
+		UptimeSeconds:       int64(uptime / time.Second),
+		DaemonUptimeSeconds: int64(daemonUptime / time.Second),
+		DaemonRestarts:      int32(a.lnDaemon.Restarts()),
+		PaymentsSucceeded:   succeeded,
+		PaymentsFailed:      failed,
+		AvgPathfindingMs:    int64(avgPathfinding / time.Millisecond),
+	}
+}
+
+func (a *App) StorageReport() (*data.StorageReport, error) {
+	return a.lnDaemon.StorageReport()
+}
+
+func (a *App) ReadinessState() (*data.ReadinessState, error) {
+	return a.lnDaemon.ReadinessState()
+}
+
+func (a *App) SetChannelAcceptor(acceptor lnnode.ChannelAcceptor) {
+	a.lnDaemon.SetChannelAcceptor(acceptor)
+}
+
+func (a *App) BakeMacaroon(request *data.BakeMacaroonRequest) (*data.BakeMacaroonReply, error) {
+	mac, err := a.lnDaemon.BakeMacaroon(request.Permissions, time.Duration(request.TtlSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return &data.BakeMacaroonReply{Macaroon: mac}, nil
+}
+
 func (a *App) LastSyncedHeaderTimestamp() (int64, error) {
 	return a.breezDB.FetchLastSyncedHeaderTimestamp()
 }
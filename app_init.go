@@ -6,11 +6,13 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"strconv"
 	"sync"
 
 	"github.com/breez/breez/account"
 	"github.com/breez/breez/backup"
 	"github.com/breez/breez/chainservice"
+	"github.com/breez/breez/channeldbservice"
 	"github.com/breez/breez/config"
 	"github.com/breez/breez/data"
 	"github.com/breez/breez/db"
@@ -113,6 +115,16 @@ func NewApp(workingDir string, applicationServices AppServices, startBeforeSync
 		return nil, err
 	}
 
+	// channel.db is compacted by whoever opens it first, which may be before
+	// the notifications are delivered, so don't block on them.
+	channeldbservice.SetCompactionProgress(func(percent int) {
+		app.log.Infof("channel.db compaction progress: %v%%", percent)
+		go app.notify(data.NotificationEvent{
+			Type: data.NotificationEvent_CHANNEL_DB_COMPACTION,
+			Data: []string{strconv.Itoa(percent)},
+		})
+	})
+
 	app.lnDaemon, err = lnnode.NewDaemon(app.cfg, app.breezDB, startBeforeSync)
 	if err != nil {
 		return nil, fmt.Errorf("Failed to create lnnode.Daemon: %v", err)
@@ -170,6 +182,7 @@ func NewApp(workingDir string, applicationServices AppServices, startBeforeSync
 		app.AccountService.AddInvoice,
 		app.ServicesClient.LSPList,
 		app.AccountService.GetGlobalMaxReceiveLimit,
+		app.AccountService.FeeRates,
 		app.onServiceEvent,
 	)
 	app.log.Infof("New SwapService")
@@ -10,6 +10,7 @@ import (
 	"path"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/breez/boltz"
 	"github.com/breez/breez"
@@ -52,6 +53,21 @@ type AppServices interface {
 	BackupProviderSignIn() (string, error)
 }
 
+// ChannelAcceptor is implemented by the host application to decide whether
+// an inbound channel is accepted. The request is a serialized
+// lnrpc.ChannelAcceptRequest and returning an error rejects the channel.
+type ChannelAcceptor interface {
+	AcceptChannel(request []byte) error
+}
+
+// PaymentInterceptor is implemented by the host application to approve the
+// outgoing payments above the threshold passed to SetPaymentInterceptor.
+// Returning an error rejects the payment. maxFeeSat is -1 if the fee isn't
+// limited.
+type PaymentInterceptor interface {
+	InterceptPayment(amountSat int64, destination string, maxFeeSat int64) error
+}
+
 // Logger is an interface that is used to log to the central log file.
 type Logger interface {
 	Log(msg string, lvl string)
@@ -190,6 +206,45 @@ func Start() error {
 	return nil
 }
 
+func SetChannelAcceptor(acceptor ChannelAcceptor) {
+	if acceptor == nil {
+		getBreezApp().SetChannelAcceptor(nil)
+		return
+	}
+	getBreezApp().SetChannelAcceptor(lnnode.ChannelAcceptorFunc(
+		func(request *lnrpc.ChannelAcceptRequest) error {
+			b, err := proto.Marshal(request)
+			if err != nil {
+				return err
+			}
+			return acceptor.AcceptChannel(b)
+		}))
+}
+
+func SetPaymentInterceptor(interceptor PaymentInterceptor, thresholdSat int64) {
+	getBreezApp().AccountService.SetPaymentInterceptor(interceptor, thresholdSat)
+}
+
+func BakeMacaroon(request []byte) ([]byte, error) {
+	var bakeRequest data.BakeMacaroonRequest
+	if err := proto.Unmarshal(request, &bakeRequest); err != nil {
+		return nil, err
+	}
+	return marshalResponse(getBreezApp().BakeMacaroon(&bakeRequest))
+}
+
+func SetChannelAcceptPolicy(request []byte) error {
+	var policy data.ChannelAcceptPolicy
+	if err := proto.Unmarshal(request, &policy); err != nil {
+		return err
+	}
+	getBreezApp().SetChannelAcceptor(&lnnode.ChannelAcceptPolicy{
+		PrivateOnly:    policy.PrivateOnly,
+		MinChannelSize: policy.MinChannelSize,
+	})
+	return nil
+}
+
 /*
 LastSyncedHeaderTimestamp returns the last header the node is synced to.
 */
@@ -205,6 +260,14 @@ func RestartDaemon() error {
 	return getBreezApp().RestartDaemon()
 }
 
+/*
+ResetDaemonCrashLoop allows restarting the daemon after it crashed too many
+times in a row.
+*/
+func ResetDaemonCrashLoop() {
+	getBreezApp().ResetDaemonCrashLoop()
+}
+
 /*
 NewSyncJob starts breez only to reach synchronized state.
 The daemon closes itself automatically when reaching this state.
@@ -223,6 +286,38 @@ func NewSyncJob(workingDir string) (ChannelsWatcherJobController, error) {
 	return job, nil
 }
 
+type timeBoxedSyncJob struct {
+	job    *breezSync.Job
+	budget time.Duration
+}
+
+func (j *timeBoxedSyncJob) Run() (bool, error) {
+	return j.job.SyncChainFor(j.budget)
+}
+
+func (j *timeBoxedSyncJob) Stop() {
+	j.job.Stop()
+}
+
+/*
+SyncChainFor is like NewSyncJob but the returned job syncs for at most the
+given number of seconds, to fit the background tasks limits of the OS.
+The progress is kept and the next job resumes from it.
+*/
+func SyncChainFor(workingDir string, seconds int64) (ChannelsWatcherJobController, error) {
+	if _, err := os.Stat(path.Join(workingDir, forceRescan)); err == nil {
+		return nil, ErrorForceRescan
+	}
+	if _, err := os.Stat(path.Join(workingDir, forceBootstrap)); err == nil {
+		return nil, ErrorForceBootstrap
+	}
+	job, err := breezSync.NewJob(workingDir)
+	if err != nil {
+		return nil, err
+	}
+	return &timeBoxedSyncJob{job: job, budget: time.Duration(seconds) * time.Second}, nil
+}
+
 /*
 NewClosedChannelsJob starts a job to download the list of closed channels.
 The daemon closes itself automatically when reaching this state.
@@ -474,6 +569,23 @@ func AddInvoice(invoice []byte) ([]byte, error) {
 	return marshalResponse(&data.AddInvoiceReply{PaymentRequest: payreq, LspFee: fee}, err)
 }
 
+func AddHoldInvoice(request []byte) ([]byte, error) {
+	var holdRequest data.AddHoldInvoiceRequest
+	if err := proto.Unmarshal(request, &holdRequest); err != nil {
+		return nil, err
+	}
+	payreq, err := getBreezApp().AccountService.AddHoldInvoice(&holdRequest)
+	return marshalResponse(&data.AddInvoiceReply{PaymentRequest: payreq}, err)
+}
+
+func SettleHoldInvoice(preimage string) error {
+	return getBreezApp().AccountService.SettleHoldInvoice(preimage)
+}
+
+func CancelHoldInvoice(paymentHash string) error {
+	return getBreezApp().AccountService.CancelHoldInvoice(paymentHash)
+}
+
 func SetNonBlockingUnconfirmedSwaps() error {
 	return getBreezApp().SwapService.SetNonBlockingUnconfirmed()
 }
@@ -651,10 +763,34 @@ func SetPeers(request []byte) error {
 	return err
 }
 
+func PeerBans() ([]byte, error) {
+	return marshalResponse(getBreezApp().PeerBans())
+}
+
+func BanPeer(request []byte) ([]byte, error) {
+	var r data.BanPeerRequest
+	if err := proto.Unmarshal(request, &r); err != nil {
+		return nil, err
+	}
+	return marshalResponse(getBreezApp().BanPeer(r.Peer, r.Reason, time.Duration(r.DurationSeconds)*time.Second))
+}
+
+func UnbanPeer(peer string) error {
+	return getBreezApp().UnbanPeer(peer)
+}
+
 func TestPeer(peer string) error {
 	return getBreezApp().TestPeer(peer)
 }
 
+func PeerDiagnostics(peer string) ([]byte, error) {
+	return marshalResponse(getBreezApp().PeerDiagnostics(peer))
+}
+
+func SetMeteredConnection(metered bool) {
+	chainservice.SetMetered(metered)
+}
+
 func DeleteGraph() error {
 	return getBreezApp().DeleteGraph()
 }
@@ -697,6 +833,22 @@ func TestTxSpentURL(txSpentURL string) error {
 	return lnnode.TestTxSpentURL(txSpentURL)
 }
 
+func HealthCheck() ([]byte, error) {
+	return marshalResponse(getBreezApp().HealthCheck())
+}
+
+func NodeStats() ([]byte, error) {
+	return marshalResponse(getBreezApp().NodeStats(), nil)
+}
+
+func StorageReport() ([]byte, error) {
+	return marshalResponse(getBreezApp().StorageReport())
+}
+
+func ReadinessState() ([]byte, error) {
+	return marshalResponse(getBreezApp().ReadinessState())
+}
+
 func HasClosedChannels() (bool, error) {
 	c, err := getBreezApp().ClosedChannels()
 	return c > 0, err
@@ -778,8 +930,23 @@ func ConnectDirectToLnurl(channel []byte) error {
 	return getBreezApp().AccountService.OpenDirectLnurlChannel(c.K1, c.Callback, c.Uri)
 }
 
+func FinishLNURLChannel(request []byte) error {
+	var r data.FinishLNURLChannelRequest
+	if err := proto.Unmarshal(request, &r); err != nil {
+		return err
+	}
+	if r.Channel == nil {
+		return errors.New("missing lnurl channel")
+	}
+	ctx, cancel := getBreezApp().AccountService.NewLNURLContext()
+	defer cancel()
+	return getBreezApp().AccountService.FinishLNURLChannel(ctx, r.Channel)
+}
+
 func FetchLnurl(lnurl string) ([]byte, error) {
-	result, err := marshalResponse(getBreezApp().AccountService.HandleLNURL(lnurl))
+	ctx, cancel := getBreezApp().AccountService.NewLNURLContext()
+	defer cancel()
+	result, err := marshalResponse(getBreezApp().AccountService.HandleLNURL(ctx, lnurl))
 	Log(fmt.Sprintf("FetchLnurl: %v", result), "INFO")
 	return result, err
 }
@@ -789,11 +956,65 @@ func FinishLNURLAuth(request []byte) (string, error) {
 	if err := proto.Unmarshal(request, &authData); err != nil {
 		return "", err
 	}
-	return getBreezApp().AccountService.FinishLNURLAuth(&authData)
+	ctx, cancel := getBreezApp().AccountService.NewLNURLContext()
+	defer cancel()
+	return getBreezApp().AccountService.FinishLNURLAuth(ctx, &authData)
+}
+
+func WithdrawLnurl(request []byte, bolt11 string) error {
+	var withdraw data.LNUrlWithdraw
+	if err := proto.Unmarshal(request, &withdraw); err != nil {
+		return err
+	}
+	ctx, cancel := getBreezApp().AccountService.NewLNURLContext()
+	defer cancel()
+	return getBreezApp().AccountService.FinishLNURLWithdraw(ctx, &withdraw, bolt11)
+}
+
+func WithdrawLNURL(request []byte) ([]byte, error) {
+	var r data.WithdrawLNURLRequest
+	if err := proto.Unmarshal(request, &r); err != nil {
+		return nil, err
+	}
+	ctx, cancel := getBreezApp().AccountService.NewLNURLContext()
+	defer cancel()
+	return marshalResponse(getBreezApp().AccountService.WithdrawLNURL(ctx, &r))
+}
+
+func LNURLAuthScheme() (string, error) {
+	return getBreezApp().AccountService.LNURLAuthScheme()
+}
+
+func SetLNURLAuthScheme(scheme string) error {
+	return getBreezApp().AccountService.SetLNURLAuthScheme(scheme)
+}
+
+func ListLNURLAuthIdentities() ([]byte, error) {
+	return marshalResponse(getBreezApp().AccountService.ListLNURLAuthIdentities())
+}
+
+func LNURLAuthLinkingPubkey(host string) (string, error) {
+	return getBreezApp().AccountService.LNURLAuthLinkingPubkey(host)
+}
+
+func RevokeLNURLAuthToken(host string) error {
+	return getBreezApp().AccountService.RevokeLNURLAuthToken(host)
+}
+
+func GetLNURLAuthToken(host string) (string, error) {
+	return getBreezApp().AccountService.GetLNURLAuthToken(host)
+}
+
+func ForgetLNURLAuthIdentity(host string) error {
+	return getBreezApp().AccountService.ForgetLNURLAuthIdentity(host)
 }
 
-func WithdrawLnurl(bolt11 string) error {
-	return getBreezApp().AccountService.FinishLNURLWithdraw(bolt11)
+func CancelLNURLRequests() {
+	getBreezApp().AccountService.CancelLNURLRequests()
+}
+
+func SetLNURLProxy(proxy string) error {
+	return getBreezApp().AccountService.SetLNURLProxy(proxy)
 }
 
 func FinishLNURLPay(request []byte) (result []byte, err error) {
@@ -803,7 +1024,9 @@ func FinishLNURLPay(request []byte) (result []byte, err error) {
 		return nil, errors.New("FinishLNURLPay: Failed to unmarshal data.")
 	}
 
-	result, err = marshalResponse(getBreezApp().AccountService.FinishLNURLPay(&d))
+	ctx, cancel := getBreezApp().AccountService.NewLNURLContext()
+	defer cancel()
+	result, err = marshalResponse(getBreezApp().AccountService.FinishLNURLPay(ctx, &d))
 	if err != nil {
 		Log(fmt.Sprintf("FinishLNURLPay error: %s", err), "WARNING")
 		return nil, err // FIXME TEST Is this actually returning an error that the client can use?
@@ -813,6 +1036,41 @@ func FinishLNURLPay(request []byte) (result []byte, err error) {
 	return result, nil
 }
 
+func VerifyLNURLPayment(paymentHash string) ([]byte, error) {
+	ctx, cancel := getBreezApp().AccountService.NewLNURLContext()
+	defer cancel()
+	return marshalResponse(getBreezApp().AccountService.VerifyLNURLPayment(ctx, paymentHash))
+}
+
+func FinishLNURLPayAndSend(request []byte) ([]byte, error) {
+	var r data.LNURLPayAndSendRequest
+	if err := proto.Unmarshal(request, &r); err != nil {
+		return nil, err
+	}
+	if r.Pay == nil {
+		return nil, errors.New("missing lnurl-pay params")
+	}
+	ctx, cancel := getBreezApp().AccountService.NewLNURLContext()
+	defer cancel()
+	return marshalResponse(getBreezApp().AccountService.FinishLNURLPayAndSend(ctx, r.Pay, r.MaxFeeSat))
+}
+
+func ListLNUrlPayInfos(request []byte) ([]byte, error) {
+	var r data.LNUrlPayInfosRequest
+	if err := proto.Unmarshal(request, &r); err != nil {
+		return nil, err
+	}
+	return marshalResponse(getBreezApp().AccountService.ListLNUrlPayInfos(r.Host, r.Offset, r.Limit))
+}
+
+func ListSavedPayLinks() ([]byte, error) {
+	return marshalResponse(getBreezApp().AccountService.ListSavedPayLinks())
+}
+
+func DeleteSavedPayLink(callback string) error {
+	return getBreezApp().AccountService.DeleteSavedPayLink(callback)
+}
+
 func NewReverseSwap(request []byte) (string, error) {
 	var swapRequest data.ReverseSwapRequest
 	if err := proto.Unmarshal(request, &swapRequest); err != nil {
@@ -892,6 +1150,10 @@ func FetchReverseSwap(hash string) ([]byte, error) {
 	return marshalResponse(getBreezApp().SwapService.FetchReverseSwap(hash))
 }
 
+func FeeEstimates() ([]byte, error) {
+	return marshalResponse(getBreezApp().AccountService.FeeEstimates())
+}
+
 func ReverseSwapClaimFeeEstimates(claimAddress string) ([]byte, error) {
 	cf, err := getBreezApp().SwapService.ClaimFeeEstimates(claimAddress)
 	return marshalResponse(&data.ClaimFeeEstimates{Fees: cf}, err)
@@ -932,6 +1194,193 @@ func SweepAllCoinsTransactions(address string) ([]byte, error) {
 	)
 }
 
+func SweepAllCoinsTransactionsWithMinConfs(address string, minConfs int32) ([]byte, error) {
+	return marshalResponse(
+		getBreezApp().AccountService.SweepAllCoinsTransactionsWithMinConfs(address, minConfs),
+	)
+}
+
+func SweepAllCoinsToDestinations(request []byte) ([]byte, error) {
+	var destinations data.SweepDestinations
+	if err := proto.Unmarshal(request, &destinations); err != nil {
+		return nil, err
+	}
+	return marshalResponse(
+		getBreezApp().AccountService.SweepAllCoinsToDestinations(destinations.Destinations),
+	)
+}
+
+func SweepAllCoinsPsbts(address string) ([]byte, error) {
+	return marshalResponse(
+		getBreezApp().AccountService.SweepAllCoinsPsbts(address),
+	)
+}
+
+func SweepCoinsTransaction(request []byte) ([]byte, error) {
+	var sweepRequest data.SweepCoinsRequest
+	if err := proto.Unmarshal(request, &sweepRequest); err != nil {
+		return nil, err
+	}
+	return marshalResponse(
+		getBreezApp().AccountService.SweepCoinsTransaction(&sweepRequest),
+	)
+}
+
+func SendCoins(request []byte) ([]byte, error) {
+	var sendRequest data.SendCoinsRequest
+	if err := proto.Unmarshal(request, &sendRequest); err != nil {
+		return nil, err
+	}
+	return marshalResponse(
+		getBreezApp().AccountService.SendCoins(sendRequest.Address, sendRequest.Amount, sendRequest.SatPerVbyte),
+	)
+}
+
+func BumpFee(request []byte) ([]byte, error) {
+	var bumpFeeRequest data.BumpFeeRequest
+	if err := proto.Unmarshal(request, &bumpFeeRequest); err != nil {
+		return nil, err
+	}
+	return marshalResponse(
+		getBreezApp().AccountService.BumpFee(bumpFeeRequest.Txid, bumpFeeRequest.SatPerVbyte),
+	)
+}
+
+func CPFP(request []byte) error {
+	var bumpFeeRequest data.BumpFeeRequest
+	if err := proto.Unmarshal(request, &bumpFeeRequest); err != nil {
+		return err
+	}
+	return getBreezApp().AccountService.CPFP(bumpFeeRequest.Txid, bumpFeeRequest.SatPerVbyte)
+}
+
+func NewAddress(addressType string) (string, error) {
+	return getBreezApp().AccountService.NewAddress(addressType)
+}
+
+func SignMessageWithAddress(address, message string) (string, error) {
+	return getBreezApp().AccountService.SignMessageWithAddress(address, message)
+}
+
+func VerifyMessageWithAddress(address, message, signature string) (bool, error) {
+	return getBreezApp().AccountService.VerifyMessageWithAddress(address, message, signature)
+}
+
+func OnchainAddresses() ([]byte, error) {
+	return marshalResponse(getBreezApp().AccountService.OnchainAddresses())
+}
+
+func WatchAddress(request []byte) error {
+	var watched data.WatchedAddress
+	if err := proto.Unmarshal(request, &watched); err != nil {
+		return err
+	}
+	return getBreezApp().AccountService.WatchAddress(&watched)
+}
+
+func UnwatchAddress(request []byte) error {
+	var watched data.WatchedAddress
+	if err := proto.Unmarshal(request, &watched); err != nil {
+		return err
+	}
+	return getBreezApp().AccountService.UnwatchAddress(&watched)
+}
+
+func WatchedAddresses() ([]byte, error) {
+	return marshalResponse(getBreezApp().AccountService.WatchedAddresses())
+}
+
+func TimelockedFunds() ([]byte, error) {
+	return marshalResponse(getBreezApp().AccountService.TimelockedFunds())
+}
+
+func ClaimTimelockedFunds(request []byte) ([]byte, error) {
+	var sweepRequest data.SweepCoinsRequest
+	if err := proto.Unmarshal(request, &sweepRequest); err != nil {
+		return nil, err
+	}
+	return marshalResponse(
+		getBreezApp().AccountService.ClaimTimelockedFunds(sweepRequest.Address, sweepRequest.SatPerVbyte),
+	)
+}
+
+func SetAutoSweepPolicy(request []byte) error {
+	var policy data.AutoSweepPolicy
+	if err := proto.Unmarshal(request, &policy); err != nil {
+		return err
+	}
+	return getBreezApp().AccountService.SetAutoSweepPolicy(&policy)
+}
+
+func DisableAutoSweep() error {
+	return getBreezApp().AccountService.SetAutoSweepPolicy(nil)
+}
+
+func GetAutoSweepPolicy() ([]byte, error) {
+	return marshalResponse(getBreezApp().AccountService.AutoSweepPolicy())
+}
+
+func OpenChannel(request []byte) ([]byte, error) {
+	var openRequest data.OpenChannelRequest
+	if err := proto.Unmarshal(request, &openRequest); err != nil {
+		return nil, err
+	}
+	channelPoint, err := getBreezApp().AccountService.OpenChannel(openRequest.NodeId,
+		openRequest.LocalAmount, openRequest.SatPerVbyte, openRequest.Private)
+	return marshalResponse(&data.OpenChannelReply{ChannelPoint: channelPoint}, err)
+}
+
+func ListClosedChannels() ([]byte, error) {
+	return marshalResponse(getBreezApp().AccountService.ListClosedChannels())
+}
+
+func EstimateRoute(request []byte) ([]byte, error) {
+	var estimateRequest data.EstimateRouteRequest
+	if err := proto.Unmarshal(request, &estimateRequest); err != nil {
+		return nil, err
+	}
+	return marshalResponse(getBreezApp().AccountService.EstimateRoute(estimateRequest.Destination,
+		estimateRequest.Amount, estimateRequest.Probe))
+}
+
+func CloseChannel(request []byte) ([]byte, error) {
+	var closeRequest data.CloseChannelRequest
+	if err := proto.Unmarshal(request, &closeRequest); err != nil {
+		return nil, err
+	}
+	closingTxid, err := getBreezApp().AccountService.CloseChannel(closeRequest.ChannelPoint,
+		closeRequest.Force, closeRequest.SatPerVbyte)
+	return marshalResponse(&data.CloseChannelReply{ClosingTxid: closingTxid}, err)
+}
+
+func PruneChannelDB(olderThanSeconds int64) ([]byte, error) {
+	payments, invoices, err := getBreezApp().PruneChannelDB(time.Duration(olderThanSeconds) * time.Second)
+	return marshalResponse(&data.PruneChannelDBReply{
+		DeletedPayments: int32(payments),
+		DeletedInvoices: int32(invoices),
+	}, err)
+}
+
+func ExportSCB() ([]byte, error) {
+	return getBreezApp().AccountService.ExportSCB()
+}
+
+func SetMPPSettings(request []byte) error {
+	var settings data.MPPSettings
+	if err := proto.Unmarshal(request, &settings); err != nil {
+		return err
+	}
+	return getBreezApp().AccountService.SetMPPSettings(&settings)
+}
+
+func GetMPPSettings() ([]byte, error) {
+	return marshalResponse(getBreezApp().AccountService.MPPSettings())
+}
+
+func ListUTXOs() ([]byte, error) {
+	return marshalResponse(getBreezApp().AccountService.ListUTXOs())
+}
+
 func SyncGraphFromFile(sourceFilePath string) error {
 	Log("SyncGraphFromFile started", "INFO")
 	err := bootstrap.SyncGraphDB(getBreezApp().GetWorkingDir(), sourceFilePath)
@@ -948,6 +1397,18 @@ func PublishTransaction(tx []byte) error {
 	return getBreezApp().AccountService.PublishTransaction(tx)
 }
 
+func PublishTransactionWithLabel(tx []byte, label string) error {
+	return getBreezApp().AccountService.PublishTransactionWithLabel(tx, label)
+}
+
+func ExportOnchainHistory(format string) ([]byte, error) {
+	return getBreezApp().AccountService.ExportOnchainHistory(format)
+}
+
+func ListOnchainTransactions() ([]byte, error) {
+	return marshalResponse(getBreezApp().AccountService.ListOnchainTransactions())
+}
+
 func deliverNotifications(notificationsChan chan data.NotificationEvent, appServices AppServices) {
 	for {
 		notification := <-notificationsChan
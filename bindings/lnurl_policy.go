@@ -0,0 +1,46 @@
+package bindings
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"net/url"
+)
+
+// NativeLNURLPolicy is implemented by the native platform to control which
+// lnurl services the wallet talks to. AllowLNURLRequest is called with the url
+// of every lnurl request and AllowLNURLCertificate with the hex encoded
+// sha256 fingerprint of the leaf certificate presented by host.
+// Returning an error aborts the request.
+type NativeLNURLPolicy interface {
+	AllowLNURLRequest(host string, url string) error
+	AllowLNURLCertificate(host string, fingerprint string) error
+}
+
+// nativeLNURLPolicyBridge adapts a NativeLNURLPolicy to account.LNURLPolicy.
+type nativeLNURLPolicyBridge struct {
+	nativePolicy NativeLNURLPolicy
+}
+
+func (b *nativeLNURLPolicyBridge) AllowRequest(u *url.URL) error {
+	return b.nativePolicy.AllowLNURLRequest(u.Hostname(), u.String())
+}
+
+func (b *nativeLNURLPolicyBridge) AllowCertificate(host string, certs []*x509.Certificate) error {
+	if len(certs) == 0 {
+		return errors.New("no certificate")
+	}
+	fingerprint := sha256.Sum256(certs[0].Raw)
+	return b.nativePolicy.AllowLNURLCertificate(host, hex.EncodeToString(fingerprint[:]))
+}
+
+// SetLNURLPolicy sets the policy applied to all the lnurl requests.
+// Passing nil removes the policy.
+func SetLNURLPolicy(policy NativeLNURLPolicy) {
+	if policy == nil {
+		getBreezApp().AccountService.SetLNURLPolicy(nil)
+		return
+	}
+	getBreezApp().AccountService.SetLNURLPolicy(&nativeLNURLPolicyBridge{nativePolicy: policy})
+}
@@ -102,7 +102,7 @@ func GraphURL(workingDir string, breezDB *db.DB) (string, error) {
 		return url, nil
 	}
 
-	chainService, chainServiceCleanUp, err := chainservice.Get(workingDir, breezDB)
+	chainService, chainServiceCleanUp, err := chainservice.GetBlockSource(workingDir, breezDB)
 	if err != nil {
 		//chanDBCleanUp()
 		logger.Errorf("failed to create chainservice: %v", err)
@@ -0,0 +1,66 @@
+package chainservice
+
+import (
+	"github.com/breez/breez/config"
+	"github.com/breez/breez/db"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/neutrino/headerfs"
+)
+
+// BlockSource looks up the blocks of the chain backend.
+type BlockSource interface {
+	BestBlock() (*headerfs.BlockStamp, error)
+	GetBlockHash(height int64) (*chainhash.Hash, error)
+	GetBlockHeader(hash *chainhash.Hash) (*wire.BlockHeader, error)
+}
+
+/*
+GetBlockSource returns the configured bitcoind node when it is the chain
+backend, and the shared ChainService otherwise, so the blocks can be looked up
+without starting neutrino next to bitcoind.
+*/
+func GetBlockSource(workingDir string, breezDB *db.DB) (BlockSource, func() error, error) {
+	config, err := config.GetConfig(workingDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	if config.BitcoindCfg.RPCHost == "" {
+		return Get(workingDir, breezDB)
+	}
+	client, err := rpcclient.New(&rpcclient.ConnConfig{
+		Host:         config.BitcoindCfg.RPCHost,
+		User:         config.BitcoindCfg.RPCUser,
+		Pass:         config.BitcoindCfg.RPCPass,
+		DisableTLS:   true,
+		HTTPPostMode: true,
+	}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &bitcoindBlockSource{client}, func() error {
+		client.Shutdown()
+		return nil
+	}, nil
+}
+
+type bitcoindBlockSource struct {
+	*rpcclient.Client
+}
+
+func (b *bitcoindBlockSource) BestBlock() (*headerfs.BlockStamp, error) {
+	hash, height, err := b.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.GetBlockHeader(hash)
+	if err != nil {
+		return nil, err
+	}
+	return &headerfs.BlockStamp{
+		Height:    height,
+		Hash:      *hash,
+		Timestamp: header.Timestamp,
+	}, nil
+}
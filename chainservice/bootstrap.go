@@ -22,6 +22,7 @@ var (
 	waddrmgrNamespace = []byte("waddrmgr")
 	syncBucketName    = []byte("sync")
 	birthdayBlockName = []byte("birthday")
+	birthdayHeightKey = []byte("birthdayblock")
 )
 
 // ResetChainService deletes neutrino headers/cfheaders and the db so
@@ -106,6 +107,11 @@ func Bootstrap(workingDir string) error {
 	}
 
 	logger.Info("staring bootstrap flow")
+	tipHeight, err := chainTipHeight(workingDir)
+	if err != nil {
+		return err
+	}
+
 	//create temporary neturino db.
 	neutrinoDataDir, db, err := getNeutrinoDB(workingDir)
 	if err != nil {
@@ -119,6 +125,19 @@ func Bootstrap(workingDir string) error {
 	}
 	logger.Infof("bootstrapping using birthday: %v", birthday)
 
+	config, err := config.GetConfig(workingDir)
+	if err != nil {
+		return err
+	}
+	if config.SnapshotCfg.URL != "" && config.SnapshotCfg.PubKey != "" {
+		snapshotHeight, err := bootstrapFromSnapshot(db, neutrinoDataDir, config, tipHeight)
+		if err == nil {
+			logger.Infof("bootstrapped from snapshot at height: %v", snapshotHeight)
+			return nil
+		}
+		logger.Errorf("failed to bootstrap from snapshot, using checkpoints: %v", err)
+	}
+
 	tipCheckpoint := getLatestCheckpoint(*birthday)
 	logger.Infof("bootstrapping using checkpoint height: %v", tipCheckpoint.Height)
 
@@ -208,21 +227,24 @@ func ensureMinimumTip(db walletdb.DB, bootstrapDir string, startHeader *headerfs
 
 func updateDBTip(db walletdb.DB, height uint32, hash chainhash.Hash) error {
 	return walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
-		rootBucket := tx.ReadWriteBucket([]byte("header-index"))
-		var heightBytes [4]byte
-		binary.BigEndian.PutUint32(heightBytes[:], height)
-		err := rootBucket.Put(hash[:], heightBytes[:])
-		if err != nil {
-			return err
-		}
-
-		if err = rootBucket.Put([]byte("bitcoin"), hash[:]); err != nil {
-			return err
-		}
-		return rootBucket.Put([]byte("regular"), hash[:])
+		return putDBTip(tx.ReadWriteBucket([]byte("header-index")), height, hash)
 	})
 }
 
+func putDBTip(rootBucket walletdb.ReadWriteBucket, height uint32, hash chainhash.Hash) error {
+	var heightBytes [4]byte
+	binary.BigEndian.PutUint32(heightBytes[:], height)
+	err := rootBucket.Put(hash[:], heightBytes[:])
+	if err != nil {
+		return err
+	}
+
+	if err = rootBucket.Put([]byte("bitcoin"), hash[:]); err != nil {
+		return err
+	}
+	return rootBucket.Put([]byte("regular"), hash[:])
+}
+
 // chainTipHeight returns the current headers tip.
 func chainTipHeight(workingDir string) (uint32, error) {
 	config, err := config.GetConfig(workingDir)
@@ -50,6 +50,12 @@ func deleteCompactFilters(neutrinoFile string) error {
 }
 
 func BoltCopy(srcfile, destfile string, skip skipFunc) error {
+	return BoltCopyWithProgress(srcfile, destfile, skip, nil)
+}
+
+// BoltCopyWithProgress is like BoltCopy but also calls progress with the
+// estimated percentage of the data copied so far.
+func BoltCopyWithProgress(srcfile, destfile string, skip skipFunc, progress func(percent int)) error {
 	// Open source database.
 	src, err := bbolt.Open(srcfile, 0444, nil)
 	if err != nil {
@@ -65,13 +71,33 @@ func BoltCopy(srcfile, destfile string, skip skipFunc) error {
 	defer dst.Close()
 
 	// Run compaction.
-	err = compact(dst, src, skip)
+	err = compact(dst, src, skip, progress)
+	if err == nil && progress != nil {
+		progress(100)
+	}
 	return err
 }
 
-func compact(dst, src *bbolt.DB, skip skipFunc) error {
-	// commit regularly, or we'll run out of memory for large datasets if using one transaction.
+// usedSize estimates the size of the data in db by excluding its free pages.
+func usedSize(db *bbolt.DB) int64 {
 	var size int64
+	db.View(func(tx *bbolt.Tx) error {
+		size = tx.Size()
+		return nil
+	})
+	stats := db.Stats()
+	size -= int64(stats.FreePageN+stats.PendingPageN) * int64(db.Info().PageSize)
+	if size <= 0 {
+		return 1
+	}
+	return size
+}
+
+func compact(dst, src *bbolt.DB, skip skipFunc, progress func(percent int)) error {
+	// commit regularly, or we'll run out of memory for large datasets if using one transaction.
+	var size, copied int64
+	var lastPercent int
+	total := usedSize(src)
 	tx, err := dst.Begin(true)
 	if err != nil {
 		return err
@@ -86,6 +112,12 @@ func compact(dst, src *bbolt.DB, skip skipFunc) error {
 			if err := tx.Commit(); err != nil {
 				return err
 			}
+			copied += size
+			// The page overhead isn't counted so keep 100 for the end.
+			if percent := int(copied * 100 / total); progress != nil && percent > lastPercent && percent < 100 {
+				lastPercent = percent
+				progress(percent)
+			}
 
 			// Start new transaction.
 			tx, err = dst.Begin(true)
@@ -0,0 +1,222 @@
+package chainservice
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+
+	"github.com/breez/breez/config"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/peer"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/neutrino"
+	"github.com/lightningnetwork/lnd/tor"
+)
+
+const (
+	// crossCheckPeers is the number of peers the filter headers are
+	// compared with.
+	crossCheckPeers = 2
+)
+
+// FilterHeaderMismatch describes a filter header checkpoint served by a peer
+// that differs from the one neutrino stored.
+type FilterHeaderMismatch struct {
+	Peer   string
+	Height uint32
+	Local  chainhash.Hash
+	Remote chainhash.Hash
+}
+
+/*
+CrossCheckFilterHeaders fetches the filter headers checkpoints up to the best
+block, and the filter headers from the last checkpoint to the filter headers
+tip, from peers neutrino isn't connected to, and compares them with the
+stored filter headers. The peers are the configured verification peers, or
+found using the DNS seeds if there are none. It fails if less than two peers
+answered.
+*/
+func CrossCheckFilterHeaders(ctx context.Context, cs *neutrino.ChainService,
+	cfg *config.Config) ([]FilterHeaderMismatch, error) {
+
+	params, err := chainParams(cfg.Network)
+	if err != nil {
+		return nil, err
+	}
+	best, err := cs.BestBlock()
+	if err != nil {
+		return nil, err
+	}
+	_, filterTip, err := cs.RegFilterHeaders.ChainTip()
+	if err != nil {
+		return nil, err
+	}
+	tipHeader, err := cs.BlockHeaders.FetchHeaderByHeight(filterTip)
+	if err != nil {
+		return nil, err
+	}
+	tipHash := tipHeader.BlockHash()
+	// The tail starts after the last checkpoint below the tip.
+	tailStart := uint32(1)
+	if filterTip > 0 {
+		tailStart = (filterTip-1)/wire.CFCheckptInterval*wire.CFCheckptInterval + 1
+	}
+	peers := cfg.NeutrinoCfg.VerifyPeers
+	if len(peers) == 0 {
+		peers = seedPeers(params, cfg.NeutrinoCfg)
+	}
+	connected := make(map[string]struct{})
+	for _, p := range cs.Peers() {
+		connected[peerHost(p.Addr())] = struct{}{}
+	}
+
+	var mismatches []FilterHeaderMismatch
+	answered := 0
+	for _, addr := range peers {
+		if answered == crossCheckPeers {
+			break
+		}
+		host := peerHost(addr)
+		if _, ok := connected[host]; ok || isBanned(host) {
+			continue
+		}
+		checkpoints, tail, err := fetchFilterHeaders(ctx, addr, params, cfg.NeutrinoCfg,
+			&best.Hash, tailStart, &tipHash)
+		if err != nil {
+			logger.Infof("failed to fetch filter headers from %v: %v", addr, err)
+			continue
+		}
+		answered++
+		var heights []uint32
+		var remoteHeaders []*chainhash.Hash
+		for i, remote := range checkpoints {
+			if height := uint32(i+1) * wire.CFCheckptInterval; height < tailStart {
+				heights = append(heights, height)
+				remoteHeaders = append(remoteHeaders, remote)
+			}
+		}
+		for i := range tail {
+			heights = append(heights, tailStart+uint32(i))
+			remoteHeaders = append(remoteHeaders, &tail[i])
+		}
+		for i, remote := range remoteHeaders {
+			height := heights[i]
+			if height > filterTip {
+				break
+			}
+			local, err := cs.RegFilterHeaders.FetchHeaderByHeight(height)
+			if err != nil {
+				return nil, err
+			}
+			if *local != *remote {
+				mismatches = append(mismatches, FilterHeaderMismatch{
+					Peer:   addr,
+					Height: height,
+					Local:  *local,
+					Remote: *remote,
+				})
+				break
+			}
+		}
+	}
+	if answered < crossCheckPeers {
+		return nil, fmt.Errorf("only %v peers answered, %v needed", answered, crossCheckPeers)
+	}
+	return mismatches, nil
+}
+
+// fetchFilterHeaders returns the filter headers checkpoints of the peer up to
+// stopHash and its filter headers from tailStart to tailStop.
+func fetchFilterHeaders(ctx context.Context, addr string, params *chaincfg.Params,
+	cfg config.NeutrinoConfig, stopHash *chainhash.Hash, tailStart uint32,
+	tailStop *chainhash.Hash) ([]*chainhash.Hash, []chainhash.Hash, error) {
+
+	checkpoints := make(chan *wire.MsgCFCheckpt, 1)
+	headers := make(chan *wire.MsgCFHeaders, 1)
+	p, _, disconnect, err := connectPeer(ctx, addr, params, cfg, peer.MessageListeners{
+		OnCFCheckpt: func(_ *peer.Peer, msg *wire.MsgCFCheckpt) {
+			select {
+			case checkpoints <- msg:
+			default:
+			}
+		},
+		OnCFHeaders: func(_ *peer.Peer, msg *wire.MsgCFHeaders) {
+			select {
+			case headers <- msg:
+			default:
+			}
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer disconnect()
+	if p.Services()&wire.SFNodeCF != wire.SFNodeCF {
+		return nil, nil, fmt.Errorf("%v doesn't serve compact filters", addr)
+	}
+
+	var filterHeaders []*chainhash.Hash
+	p.QueueMessage(wire.NewMsgGetCFCheckpt(wire.GCSFilterRegular, stopHash), nil)
+	select {
+	case msg := <-checkpoints:
+		if msg.StopHash != *stopHash {
+			return nil, nil, fmt.Errorf("unexpected stop hash %v", msg.StopHash)
+		}
+		filterHeaders = msg.FilterHeaders
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	}
+
+	p.QueueMessage(wire.NewMsgGetCFHeaders(wire.GCSFilterRegular, tailStart, tailStop), nil)
+	select {
+	case msg := <-headers:
+		if msg.StopHash != *tailStop {
+			return nil, nil, fmt.Errorf("unexpected stop hash %v", msg.StopHash)
+		}
+		return filterHeaders, chainFilterHeaders(msg.PrevFilterHeader, msg.FilterHashes), nil
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	}
+}
+
+// chainFilterHeaders returns the filter headers following prevHeader of the
+// filters whose hashes are filterHashes.
+func chainFilterHeaders(prevHeader chainhash.Hash, filterHashes []*chainhash.Hash) []chainhash.Hash {
+	headers := make([]chainhash.Hash, 0, len(filterHashes))
+	for _, filterHash := range filterHashes {
+		prevHeader = chainhash.DoubleHashH(append(filterHash[:], prevHeader[:]...))
+		headers = append(headers, prevHeader)
+	}
+	return headers
+}
+
+// seedPeers resolves peers serving compact filters from the DNS seeds, in a
+// random order.
+func seedPeers(params *chaincfg.Params, cfg config.NeutrinoConfig) []string {
+	lookup := net.LookupHost
+	if cfg.Proxy != "" {
+		lookup = func(host string) ([]string, error) {
+			return tor.LookupHost(host, cfg.Proxy)
+		}
+	}
+	var peers []string
+	for _, seed := range params.DNSSeeds {
+		host := seed.Host
+		if seed.HasFiltering {
+			host = fmt.Sprintf("x%x.%v", uint64(wire.SFNodeCF|wire.SFNodeNetwork|wire.SFNodeWitness), host)
+		}
+		addrs, err := lookup(host)
+		if err != nil {
+			continue
+		}
+		for _, a := range addrs {
+			peers = append(peers, net.JoinHostPort(a, params.DefaultPort))
+		}
+	}
+	rand.Shuffle(len(peers), func(i, j int) {
+		peers[i], peers[j] = peers[j], peers[i]
+	})
+	return peers
+}
@@ -0,0 +1,42 @@
+package chainservice
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil/gcs/builder"
+)
+
+func TestChainFilterHeaders(t *testing.T) {
+	params := &chaincfg.MainNetParams
+	filter, err := builder.BuildBasicFilter(params.GenesisBlock, nil)
+	if err != nil {
+		t.Fatalf("builder.BuildBasicFilter: %v", err)
+	}
+	filterHash, err := builder.GetFilterHash(filter)
+	if err != nil {
+		t.Fatalf("builder.GetFilterHash: %v", err)
+	}
+
+	var prevHeader chainhash.Hash
+	var want []chainhash.Hash
+	for i := 0; i < 3; i++ {
+		header, err := builder.MakeHeaderForFilter(filter, prevHeader)
+		if err != nil {
+			t.Fatalf("builder.MakeHeaderForFilter: %v", err)
+		}
+		want = append(want, header)
+		prevHeader = header
+	}
+
+	got := chainFilterHeaders(chainhash.Hash{}, []*chainhash.Hash{&filterHash, &filterHash, &filterHash})
+	if len(got) != len(want) {
+		t.Fatalf("got %v headers, want %v", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("header %v = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
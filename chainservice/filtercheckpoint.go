@@ -0,0 +1,136 @@
+package chainservice
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/breez/breez/config"
+	"github.com/breez/breez/db"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/neutrino/headerfs"
+)
+
+const (
+	filterHeaderCheckpointPath    = "checkpoints/filterheader"
+	filterHeaderCheckpointTimeout = 30 * time.Second
+)
+
+// shippedFilterHeader returns the most recent filter header checkpoint
+// shipped with the app for the network, or nil if there is none.
+func shippedFilterHeader(network string) *headerfs.FilterHeader {
+	if network != "mainnet" || len(checkpoints) == 0 {
+		return nil
+	}
+	ck := checkpoints[len(checkpoints)-1]
+	return &headerfs.FilterHeader{
+		FilterHash: *ck.FilterHeader,
+		Height:     ck.Height,
+	}
+}
+
+/*
+assertFilterHeader returns the filter header neutrino asserts when it starts:
+the one given in the configuration if any, otherwise the most recent of the
+shipped checkpoint and the one fetched from the breez server.
+If the filter headers on disk don't match it they are synced anew from the
+peers, so a peer that served wrong filter headers is detected.
+*/
+func assertFilterHeader(cfg *config.Config, breezDB *db.DB) (*headerfs.FilterHeader, error) {
+	if cfg.JobCfg.AssertFilterHeader != "" {
+		return parseAssertFilterHeader(cfg.JobCfg.AssertFilterHeader)
+	}
+
+	assertion := shippedFilterHeader(cfg.Network)
+	stored, err := breezDB.GetFilterHeaderCheckpoint()
+	if err != nil {
+		return nil, err
+	}
+	fetched, err := parseAssertFilterHeader(stored)
+	if err != nil {
+		logger.Errorf("ignoring invalid stored filter header checkpoint %v: %v", stored, err)
+		return assertion, nil
+	}
+	if fetched != nil && (assertion == nil || fetched.Height > assertion.Height) {
+		assertion = fetched
+	}
+	return assertion, nil
+}
+
+// UpdateFilterHeaderCheckpoint fetches the latest filter header checkpoint
+// from the breez server and stores it to be asserted on the next start.
+func UpdateFilterHeaderCheckpoint(workingDir string, breezDB *db.DB) error {
+	cfg, err := config.GetConfig(workingDir)
+	if err != nil {
+		return err
+	}
+	if cfg.BootstrapURL == "" {
+		return nil
+	}
+	u, err := url.Parse(cfg.BootstrapURL)
+	if err != nil {
+		return fmt.Errorf("url.Parse(%v): %w", cfg.BootstrapURL, err)
+	}
+	u.Path = path.Join(u.Path, cfg.Network, filterHeaderCheckpointPath)
+
+	client := &http.Client{Timeout: filterHeaderCheckpointTimeout}
+	resp, err := client.Get(u.String())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch filter header checkpoint: %v", resp.Status)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	checkpoint := strings.TrimSpace(string(body))
+	fetched, err := parseAssertFilterHeader(checkpoint)
+	if err != nil {
+		return err
+	}
+	if fetched == nil {
+		return nil
+	}
+	if shipped := shippedFilterHeader(cfg.Network); shipped != nil && fetched.Height <= shipped.Height {
+		return nil
+	}
+	logger.Infof("storing filter header checkpoint %v", checkpoint)
+	return breezDB.SetFilterHeaderCheckpoint(checkpoint)
+}
+
+// parseAssertFilterHeader parses a filter header checkpoint given as
+// height:hash.
+func parseAssertFilterHeader(headerStr string) (*headerfs.FilterHeader, error) {
+	if headerStr == "" {
+		return nil, nil
+	}
+
+	heightAndHash := strings.Split(headerStr, ":")
+	if len(heightAndHash) != 2 {
+		return nil, fmt.Errorf("invalid filter header %v", headerStr)
+	}
+
+	height, err := strconv.ParseUint(heightAndHash[0], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter header height: %v", err)
+	}
+
+	hash, err := chainhash.NewHashFromStr(heightAndHash[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter header hash: %v", err)
+	}
+
+	return &headerfs.FilterHeader{
+		FilterHash: *hash,
+		Height:     uint32(height),
+	}, nil
+}
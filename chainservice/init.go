@@ -2,10 +2,8 @@ package chainservice
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path"
-	"strconv"
 	"strings"
 	"time"
 
@@ -14,7 +12,6 @@ import (
 	breezlog "github.com/breez/breez/log"
 	"github.com/breez/breez/refcount"
 	"github.com/btcsuite/btcd/chaincfg"
-	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btclog"
 	"github.com/btcsuite/btcwallet/walletdb"
 	"github.com/lightninglabs/neutrino"
@@ -49,61 +46,18 @@ func Get(workingDir string, breezDB *db.DB) (cs *neutrino.ChainService, cleanupF
 	return service, release, err
 }
 
-func TestPeer(peer string) error {
-	tempDir, err := ioutil.TempDir("", "testConnection")
-	if err != nil {
-		logger.Errorf("Error in ioutil.TempDir: %v", err)
-		return err
-	}
-	defer os.RemoveAll(tempDir)
-	logger.Infof("TestDir tempDir: %v", tempDir)
-
-	neutrinoDataDir := path.Join(tempDir, "data")
-	if err := os.MkdirAll(neutrinoDataDir, 0700); err != nil {
-		logger.Errorf("Error in os.MkdirAll %v", err)
-		return err
-	}
-	neutrinoDB := path.Join(neutrinoDataDir, "neutrino.db")
-	db, err := walletdb.Create("bdb", neutrinoDB, false, time.Second*60)
-	if err != nil {
-		logger.Errorf("Error in walletdb.Create: %v", err)
-		return err
-	}
-
-	neutrinoConfig := neutrino.Config{
-		DataDir:      neutrinoDataDir,
-		Database:     db,
-		ChainParams:  chaincfg.MainNetParams,
-		ConnectPeers: []string{peer},
-	}
-	chainService, err := neutrino.NewChainService(neutrinoConfig)
-	if err != nil {
-		logger.Errorf("Error in neutrino.NewChainService: %v", err)
-		return err
-	}
-	err = chainService.Start()
-	if err != nil {
-		logger.Errorf("Error in chainService.Start: %v", err)
-		return err
-	}
-	time.Sleep(10 * time.Second)
-	c := chainService.ConnectedCount()
-	if c < 1 {
-		logger.Errorf("chainService.ConnectedCount() returned 0")
-		return fmt.Errorf("Cannot connect to peer")
-	}
-	return nil
-}
-
 func createService(workingDir string, breezDB *db.DB) (*neutrino.ChainService, refcount.ReleaseFunc, error) {
 	var err error
-	neutrino.MaxPeers = 1
-	neutrino.BanDuration = 5 * time.Second
-	neutrino.ConnectionRetryInterval = 1 * time.Second
 	config, err := config.GetConfig(workingDir)
 	if err != nil {
 		return nil, nil, err
 	}
+	neutrino.MaxPeers = maxPeers(config.NeutrinoCfg)
+	// The bans neutrino makes are persisted by banLogger, which enforces them
+	// for the auto ban duration, so neutrino only needs to keep them briefly.
+	neutrino.BanDuration = 5 * time.Second
+	neutrino.ConnectionRetryInterval = 1 * time.Second
+	downloadLimit.setRate(config.NeutrinoCfg.MeteredDownloadRate)
 	if logger == nil {
 		logger, err = breezlog.GetLogger(workingDir, "CHAIN")
 		if err != nil {
@@ -111,7 +65,7 @@ func createService(workingDir string, breezDB *db.DB) (*neutrino.ChainService, r
 		}
 		logger.Infof("After get logger")
 		logger.SetLevel(btclog.LevelDebug)
-		neutrino.UseLogger(logger)
+		neutrino.UseLogger(&banLogger{Logger: logger})
 	}
 	logger.Infof("creating shared chain service.")
 
@@ -121,17 +75,31 @@ func createService(workingDir string, breezDB *db.DB) (*neutrino.ChainService, r
 		return nil, nil, err
 	}
 
-	service, walletDB, err = newNeutrino(workingDir, config, peers)
+	if err := loadPeerBans(breezDB); err != nil {
+		logger.Errorf("failed to load peer bans: %v", err)
+		return nil, nil, err
+	}
+	setAutoBanPolicy(config.NeutrinoCfg.AutoBanDuration, peers, config.JobCfg.ConnectedPeers)
+
+	assertion, err := assertFilterHeader(config, breezDB)
+	if err != nil {
+		logger.Errorf("filter header assertion error: %v", err)
+		return nil, nil, err
+	}
+
+	service, walletDB, err = newNeutrino(workingDir, config, peers, assertion)
 	if err != nil {
 		logger.Errorf("failed to create chain service %v", err)
 		return nil, stopService, err
 	}
+	startPeerMonitor(service, config.NeutrinoCfg)
 
 	logger.Infof("chain service was created successfuly")
 	return service, stopService, err
 }
 
 func stopService() error {
+	stopPeerMonitor()
 	if service != nil && service.IsStarted() {
 		if err := service.Stop(); err != nil {
 			return err
@@ -168,34 +136,12 @@ func neutrinoDataDir(workingDir string, network string) string {
 	return path.Join(workingDir, dataPath)
 }
 
-func parseAssertFilterHeader(headerStr string) (*headerfs.FilterHeader, error) {
-	if headerStr == "" {
-		return nil, nil
-	}
-
-	heightAndHash := strings.Split(headerStr, ":")
-
-	height, err := strconv.ParseUint(heightAndHash[0], 10, 32)
-	if err != nil {
-		return nil, fmt.Errorf("invalid filter header height: %v", err)
-	}
-
-	hash, err := chainhash.NewHashFromStr(heightAndHash[1])
-	if err != nil {
-		return nil, fmt.Errorf("invalid filter header hash: %v", err)
-	}
-
-	return &headerfs.FilterHeader{
-		FilterHash: *hash,
-		Height:     uint32(height),
-	}, nil
-}
-
 /*
 newNeutrino creates a chain service that the sync job uses
 in order to fetch chain data such as headers, filters, etc...
 */
-func newNeutrino(workingDir string, cfg *config.Config, peers []string) (*neutrino.ChainService, walletdb.DB, error) {
+func newNeutrino(workingDir string, cfg *config.Config, peers []string,
+	assertion *headerfs.FilterHeader) (*neutrino.ChainService, walletdb.DB, error) {
 	params, err := chainParams(cfg.Network)
 
 	if err != nil {
@@ -209,10 +155,13 @@ func newNeutrino(workingDir string, cfg *config.Config, peers []string) (*neutri
 		return nil, nil, err
 	}
 	neutrinoConfig := neutrino.Config{
-		DataDir:      neutrinoDataDir,
-		Database:     db,
-		ChainParams:  *params,
-		ConnectPeers: peers,
+		DataDir:            neutrinoDataDir,
+		Database:           db,
+		ChainParams:        *params,
+		ConnectPeers:       peers,
+		AssertFilterHeader: assertion,
+		Dialer:             limitDialer(refuseBanned(dialer(cfg.NeutrinoCfg))),
+		NameResolver:       nameResolver(cfg.NeutrinoCfg),
 	}
 	logger.Infof("creating new neutrino service.")
 	chainService, err := neutrino.NewChainService(neutrinoConfig)
@@ -241,6 +190,16 @@ func ensureNeutrinoSize(workingDir string) error {
 	}
 	neutrinoDataDir := neutrinoDataDir(workingDir, config.Network)
 	neutrinoDB := path.Join(neutrinoDataDir, "neutrino.db")
+	if err := ensureNeutrinoIntegrity(workingDir); err != nil {
+		logger.Errorf("failed to repair neutrino data %v, moving to reset chain service", err)
+		if err := resetChainService(workingDir); err != nil {
+			logger.Errorf("failed to reset chain service %v", err)
+			return err
+		}
+	}
+	if err := pruneBeforeBirthday(workingDir); err != nil {
+		logger.Errorf("failed to prune neutrino data before the wallet birthday %v", err)
+	}
 	if err := purgeOversizeFilters(neutrinoDB); err != nil {
 		logger.Errorf("failed to purgeOversizeFilters %v, moving to reset chain service", err)
 		if err := resetChainService(workingDir); err != nil {
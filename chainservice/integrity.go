@@ -0,0 +1,326 @@
+package chainservice
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"time"
+
+	"github.com/breez/breez/config"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightninglabs/neutrino/headerfs"
+)
+
+var (
+	headerIndexBucket = []byte("header-index")
+	blockTipKey       = []byte("bitcoin")
+	filterTipKey      = []byte("regular")
+)
+
+// indexSubBucketBytes is the length of the hash prefix neutrino uses to name
+// the sub buckets of the headers index.
+const indexSubBucketBytes = 2
+
+// neutrinoCorruption is returned by checkNeutrinoFiles when the headers from
+// height on can't be trusted.
+type neutrinoCorruption struct {
+	height uint32
+	reason string
+}
+
+func (c *neutrinoCorruption) Error() string {
+	return fmt.Sprintf("neutrino data corrupted at height %v: %v", c.height, c.reason)
+}
+
+// indexTip is a tip of the neutrino headers index.
+type indexTip struct {
+	hash   chainhash.Hash
+	height uint32
+}
+
+/*
+ensureNeutrinoIntegrity checks that the neutrino headers files are consistent
+with each other, with the index in neutrino.db and with the checkpoints.
+When they are not, the headers are rolled back to the last valid checkpoint so
+only the blocks after it need to be synced again. An error is returned when
+the data can't be repaired this way.
+*/
+func ensureNeutrinoIntegrity(workingDir string) error {
+	config, err := config.GetConfig(workingDir)
+	if err != nil {
+		return err
+	}
+	params, err := chainParams(config.Network)
+	if err != nil {
+		return err
+	}
+	neutrinoDataDir := neutrinoDataDir(workingDir, config.Network)
+	neutrinoDB := path.Join(neutrinoDataDir, "neutrino.db")
+	if _, err := os.Stat(neutrinoDB); os.IsNotExist(err) {
+		return nil
+	}
+	db, err := walletdb.Open("bdb", neutrinoDB, false, time.Second*60)
+	if err != nil {
+		return fmt.Errorf("failed to open neutrino.db: %w", err)
+	}
+	defer db.Close()
+	return repairNeutrinoFiles(db, neutrinoDataDir, params)
+}
+
+func repairNeutrinoFiles(db walletdb.DB, neutrinoDataDir string, params *chaincfg.Params) error {
+	err := checkNeutrinoFiles(db, neutrinoDataDir, params)
+	corruption, ok := err.(*neutrinoCorruption)
+	if !ok {
+		return err
+	}
+	logger.Errorf("%v", corruption)
+	if params.Name != chaincfg.MainNetParams.Name || corruption.height == 0 {
+		return corruption
+	}
+
+	i := int((corruption.height - 1) / wire.CFCheckptInterval)
+	if i >= len(checkpoints) {
+		i = len(checkpoints) - 1
+	}
+	ck := checkpoints[i]
+	logger.Infof("rolling back neutrino headers to checkpoint height: %v", ck.Height)
+	err = ensureMinimumTip(db, neutrinoDataDir,
+		&headerfs.BlockHeader{
+			BlockHeader: ck.BlockHeader,
+			Height:      ck.Height},
+		ck.FilterHeader, logger)
+	if err != nil {
+		return err
+	}
+	return pruneHeaderIndex(db, ck.Height)
+}
+
+/*
+checkNeutrinoFiles returns a *neutrinoCorruption if the headers files are
+truncated, behind the index tips, don't match the checkpoints or the headers
+after the last checkpoint are not chained.
+*/
+func checkNeutrinoFiles(db walletdb.DB, neutrinoDataDir string, params *chaincfg.Params) error {
+	blockTip, filterTip, err := indexTips(db)
+	if err != nil {
+		return err
+	}
+
+	headers, err := os.Open(path.Join(neutrinoDataDir, blockHeadersFile))
+	if err != nil {
+		return err
+	}
+	defer headers.Close()
+	filterHeaders, err := os.Open(path.Join(neutrinoDataDir, filterHeadersFile))
+	if err != nil {
+		return err
+	}
+	defer filterHeaders.Close()
+
+	headersInfo, err := headers.Stat()
+	if err != nil {
+		return err
+	}
+	filterHeadersInfo, err := filterHeaders.Stat()
+	if err != nil {
+		return err
+	}
+	headersCount := uint32(headersInfo.Size() / headerfs.BlockHeaderSize)
+	filterHeadersCount := uint32(filterHeadersInfo.Size() / headerfs.RegularFilterHeaderSize)
+	if headersInfo.Size()%headerfs.BlockHeaderSize != 0 {
+		return &neutrinoCorruption{height: headersCount, reason: "truncated block header"}
+	}
+	if filterHeadersInfo.Size()%headerfs.RegularFilterHeaderSize != 0 {
+		return &neutrinoCorruption{height: filterHeadersCount, reason: "truncated filter header"}
+	}
+	if headersCount <= blockTip.height {
+		return &neutrinoCorruption{height: headersCount, reason: "block headers are behind the index"}
+	}
+	if filterHeadersCount <= filterTip.height {
+		return &neutrinoCorruption{height: filterHeadersCount, reason: "filter headers are behind the index"}
+	}
+	if filterTip.height > blockTip.height {
+		return &neutrinoCorruption{height: blockTip.height + 1, reason: "filter headers are ahead of the block headers"}
+	}
+
+	start := uint32(0)
+	if params.Name == chaincfg.MainNetParams.Name {
+		raw := make([]byte, headerfs.BlockHeaderSize)
+		var filterHeader chainhash.Hash
+		for i, ck := range checkpoints {
+			height := uint32(i * wire.CFCheckptInterval)
+			if height > blockTip.height {
+				break
+			}
+			if _, err := headers.ReadAt(raw, int64(height)*headerfs.BlockHeaderSize); err != nil {
+				return err
+			}
+			var buf bytes.Buffer
+			if err := ck.BlockHeader.Serialize(&buf); err != nil {
+				return err
+			}
+			if !bytes.Equal(buf.Bytes(), raw) {
+				return &neutrinoCorruption{height: height, reason: "block header doesn't match the checkpoint"}
+			}
+			if height <= filterTip.height {
+				_, err := filterHeaders.ReadAt(filterHeader[:], int64(height)*headerfs.RegularFilterHeaderSize)
+				if err != nil {
+					return err
+				}
+				if filterHeader != *ck.FilterHeader {
+					return &neutrinoCorruption{height: height, reason: "filter header doesn't match the checkpoint"}
+				}
+			}
+			start = height
+		}
+	} else if blockTip.height > wire.CFCheckptInterval {
+		start = blockTip.height - wire.CFCheckptInterval
+	}
+
+	hashes, err := chainedHashes(headers, start, blockTip.height)
+	if err != nil {
+		return err
+	}
+	if hashes[len(hashes)-1] != blockTip.hash {
+		return &neutrinoCorruption{height: blockTip.height, reason: "block header doesn't match the index tip"}
+	}
+
+	// The filter headers tip is indexed by the hash of its block.
+	var filterTipBlock chainhash.Hash
+	if filterTip.height >= start {
+		filterTipBlock = hashes[filterTip.height-start]
+	} else {
+		raw := make([]byte, headerfs.BlockHeaderSize)
+		if _, err := headers.ReadAt(raw, int64(filterTip.height)*headerfs.BlockHeaderSize); err != nil {
+			return err
+		}
+		var header wire.BlockHeader
+		if err := header.Deserialize(bytes.NewReader(raw)); err != nil {
+			return err
+		}
+		filterTipBlock = header.BlockHash()
+	}
+	if filterTipBlock != filterTip.hash {
+		return &neutrinoCorruption{height: filterTip.height, reason: "filter headers tip doesn't match its block"}
+	}
+	return nil
+}
+
+// chainedHashes returns the hashes of the block headers from start to tip and
+// checks that each of them points to the previous one.
+func chainedHashes(headers io.ReaderAt, start, tip uint32) ([]chainhash.Hash, error) {
+	r := bufio.NewReader(io.NewSectionReader(headers,
+		int64(start)*headerfs.BlockHeaderSize, int64(tip-start+1)*headerfs.BlockHeaderSize))
+	hashes := make([]chainhash.Hash, 0, tip-start+1)
+	for height := start; height <= tip; height++ {
+		var header wire.BlockHeader
+		if err := header.Deserialize(r); err != nil {
+			return nil, err
+		}
+		if height > start && header.PrevBlock != hashes[len(hashes)-1] {
+			return nil, &neutrinoCorruption{height: height, reason: "block header is not chained"}
+		}
+		hashes = append(hashes, header.BlockHash())
+	}
+	return hashes, nil
+}
+
+// indexTips returns the block headers and filter headers tips of the
+// neutrino headers index.
+func indexTips(db walletdb.DB) (*indexTip, *indexTip, error) {
+	var blockTip, filterTip *indexTip
+	err := walletdb.View(db, func(tx walletdb.ReadTx) error {
+		rootBucket := tx.ReadBucket(headerIndexBucket)
+		if rootBucket == nil {
+			return fmt.Errorf("missing headers index")
+		}
+		var err error
+		if blockTip, err = readIndexTip(rootBucket, blockTipKey); err != nil {
+			return err
+		}
+		filterTip, err = readIndexTip(rootBucket, filterTipKey)
+		return err
+	})
+	return blockTip, filterTip, err
+}
+
+func readIndexTip(rootBucket walletdb.ReadBucket, key []byte) (*indexTip, error) {
+	hash := rootBucket.Get(key)
+	if len(hash) != chainhash.HashSize {
+		return nil, fmt.Errorf("missing %s tip in the headers index", key)
+	}
+	height := indexHeight(rootBucket, hash)
+	if len(height) != 4 {
+		return nil, fmt.Errorf("missing %s tip height in the headers index", key)
+	}
+	tip := &indexTip{height: binary.BigEndian.Uint32(height)}
+	copy(tip.hash[:], hash)
+	return tip, nil
+}
+
+// indexHeight returns the height of the hash in the headers index. Neutrino
+// puts the entries in sub buckets named by the first bytes of the hash, the
+// entries written by the older versions and by the bootstrap are in the root
+// bucket.
+func indexHeight(rootBucket walletdb.ReadBucket, hash []byte) []byte {
+	if subBucket := rootBucket.NestedReadBucket(hash[:indexSubBucketBytes]); subBucket != nil {
+		if height := subBucket.Get(hash); height != nil {
+			return height
+		}
+	}
+	return rootBucket.Get(hash)
+}
+
+// deleteIndexEntries removes the entries of the headers index, in the root
+// bucket and in the sub buckets, whose height is stale.
+func deleteIndexEntries(rootBucket walletdb.ReadWriteBucket, stale func(height uint32) bool) error {
+	var subBuckets [][]byte
+	err := rootBucket.ForEach(func(k, v []byte) error {
+		if v == nil {
+			subBuckets = append(subBuckets, append([]byte(nil), k...))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	buckets := []walletdb.ReadWriteBucket{rootBucket}
+	for _, k := range subBuckets {
+		buckets = append(buckets, rootBucket.NestedReadWriteBucket(k))
+	}
+
+	for _, bucket := range buckets {
+		var keys [][]byte
+		err := bucket.ForEach(func(k, v []byte) error {
+			if len(v) == 4 && stale(binary.BigEndian.Uint32(v)) {
+				keys = append(keys, append([]byte(nil), k...))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// pruneHeaderIndex removes the index entries of the headers above height.
+func pruneHeaderIndex(db walletdb.DB, height uint32) error {
+	return walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		return deleteIndexEntries(tx.ReadWriteBucket(headerIndexBucket), func(h uint32) bool {
+			return h > height
+		})
+	})
+}
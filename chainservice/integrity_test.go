@@ -0,0 +1,189 @@
+package chainservice
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btclog"
+	"github.com/btcsuite/btcwallet/walletdb"
+	_ "github.com/btcsuite/btcwallet/walletdb/bdb"
+	"github.com/lightninglabs/neutrino/headerfs"
+)
+
+func bootstrappedNeutrino(t *testing.T, dir string, ck Checkpoint) walletdb.DB {
+	params := &chaincfg.MainNetParams
+	db, err := walletdb.Create("bdb", path.Join(dir, "neutrino.db"), true, time.Second)
+	if err != nil {
+		t.Fatalf("walletdb.Create: %v", err)
+	}
+	if _, err := headerfs.NewBlockHeaderStore(dir, db, params); err != nil {
+		t.Fatalf("headerfs.NewBlockHeaderStore: %v", err)
+	}
+	if _, err := headerfs.NewFilterHeaderStore(dir, db, headerfs.RegularFilter, params, nil); err != nil {
+		t.Fatalf("headerfs.NewFilterHeaderStore: %v", err)
+	}
+	err = ensureMinimumTip(db, dir,
+		&headerfs.BlockHeader{BlockHeader: ck.BlockHeader, Height: ck.Height},
+		ck.FilterHeader, logger)
+	if err != nil {
+		t.Fatalf("ensureMinimumTip: %v", err)
+	}
+	return db
+}
+
+// syncedNeutrino creates a regtest neutrino.db and headers files holding the
+// headers following the genesis block, written by the neutrino stores the way
+// they are when syncing.
+func syncedNeutrino(t *testing.T, dir string, headers []wire.BlockHeader) walletdb.DB {
+	params := &chaincfg.RegressionNetParams
+	db, err := walletdb.Create("bdb", path.Join(dir, "neutrino.db"), true, time.Second)
+	if err != nil {
+		t.Fatalf("walletdb.Create: %v", err)
+	}
+	blockHeaders, err := headerfs.NewBlockHeaderStore(dir, db, params)
+	if err != nil {
+		t.Fatalf("headerfs.NewBlockHeaderStore: %v", err)
+	}
+	filterHeaders, err := headerfs.NewFilterHeaderStore(dir, db, headerfs.RegularFilter, params, nil)
+	if err != nil {
+		t.Fatalf("headerfs.NewFilterHeaderStore: %v", err)
+	}
+	var blocks []headerfs.BlockHeader
+	var filters []headerfs.FilterHeader
+	for i := 1; i < len(headers); i++ {
+		blocks = append(blocks, headerfs.BlockHeader{BlockHeader: &headers[i], Height: uint32(i)})
+		filters = append(filters, headerfs.FilterHeader{
+			HeaderHash: headers[i].BlockHash(),
+			FilterHash: chainhash.DoubleHashH(headers[i].PrevBlock[:]),
+			Height:     uint32(i),
+		})
+	}
+	if err := blockHeaders.WriteHeaders(blocks...); err != nil {
+		t.Fatalf("WriteHeaders: %v", err)
+	}
+	if err := filterHeaders.WriteHeaders(filters...); err != nil {
+		t.Fatalf("WriteHeaders: %v", err)
+	}
+	return db
+}
+
+func TestPruneHeaderIndex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "neutrino")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	headers := regtestHeaders(20, -1)
+	db := syncedNeutrino(t, dir, headers)
+	defer db.Close()
+	params := &chaincfg.RegressionNetParams
+	if err := checkNeutrinoFiles(db, dir, params); err != nil {
+		t.Fatalf("checkNeutrinoFiles of a synced neutrino: %v", err)
+	}
+
+	// The tips are moved back before the index is pruned, as when
+	// repairing.
+	if err := updateDBTip(db, 10, headers[10].BlockHash()); err != nil {
+		t.Fatalf("updateDBTip: %v", err)
+	}
+	if err := pruneHeaderIndex(db, 10); err != nil {
+		t.Fatalf("pruneHeaderIndex: %v", err)
+	}
+	store, err := headerfs.NewBlockHeaderStore(dir, db, params)
+	if err != nil {
+		t.Fatalf("headerfs.NewBlockHeaderStore: %v", err)
+	}
+	for _, height := range []int{5, 10, 11, 20} {
+		hash := headers[height].BlockHash()
+		_, _, err := store.FetchHeader(&hash)
+		if pruned := height > 10; pruned != (err != nil) {
+			t.Fatalf("FetchHeader at height %v: %v", height, err)
+		}
+	}
+}
+
+func TestRepairNeutrinoFiles(t *testing.T) {
+	if logger == nil {
+		logger = btclog.Disabled
+	}
+	dir, err := ioutil.TempDir("", "neutrino")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	db := bootstrappedNeutrino(t, dir, checkpoints[3])
+	defer db.Close()
+	params := &chaincfg.MainNetParams
+	if err := checkNeutrinoFiles(db, dir, params); err != nil {
+		t.Fatalf("checkNeutrinoFiles of a bootstrapped neutrino: %v", err)
+	}
+
+	headersPath := path.Join(dir, blockHeadersFile)
+	info, err := os.Stat(headersPath)
+	if err != nil {
+		t.Fatalf("os.Stat: %v", err)
+	}
+	if err := os.Truncate(headersPath, info.Size()-headerfs.BlockHeaderSize/2); err != nil {
+		t.Fatalf("os.Truncate: %v", err)
+	}
+	err = checkNeutrinoFiles(db, dir, params)
+	if corruption, ok := err.(*neutrinoCorruption); !ok || corruption.height != checkpoints[3].Height {
+		t.Fatalf("checkNeutrinoFiles of truncated headers: %v", err)
+	}
+
+	if err := repairNeutrinoFiles(db, dir, params); err != nil {
+		t.Fatalf("repairNeutrinoFiles: %v", err)
+	}
+	if err := checkNeutrinoFiles(db, dir, params); err != nil {
+		t.Fatalf("checkNeutrinoFiles after repair: %v", err)
+	}
+	blockTip, filterTip, err := indexTips(db)
+	if err != nil {
+		t.Fatalf("indexTips: %v", err)
+	}
+	if blockTip.height != checkpoints[2].Height || filterTip.height != checkpoints[2].Height {
+		t.Fatalf("tips after repair = %v, %v want %v", blockTip.height, filterTip.height, checkpoints[2].Height)
+	}
+}
+
+func TestCheckNeutrinoFilesLaggingFilterTip(t *testing.T) {
+	if logger == nil {
+		logger = btclog.Disabled
+	}
+	dir, err := ioutil.TempDir("", "neutrino")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	db := bootstrappedNeutrino(t, dir, checkpoints[5])
+	defer db.Close()
+
+	// The filter headers tip is two checkpoints behind the block headers.
+	lagging := checkpoints[3]
+	hash := lagging.BlockHeader.BlockHash()
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		rootBucket := tx.ReadWriteBucket(headerIndexBucket)
+		height := make([]byte, 4)
+		binary.BigEndian.PutUint32(height, lagging.Height)
+		if err := rootBucket.Put(hash[:], height); err != nil {
+			return err
+		}
+		return rootBucket.Put(filterTipKey, hash[:])
+	})
+	if err != nil {
+		t.Fatalf("walletdb.Update: %v", err)
+	}
+	if err := checkNeutrinoFiles(db, dir, &chaincfg.MainNetParams); err != nil {
+		t.Fatalf("checkNeutrinoFiles with a lagging filter tip: %v", err)
+	}
+}
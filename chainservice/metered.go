@@ -0,0 +1,117 @@
+package chainservice
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+var (
+	meteredMu sync.Mutex
+	metered   bool
+
+	// unmetered is closed when the connection is not metered.
+	unmetered = closedChan()
+
+	downloadLimit = &rateLimiter{}
+)
+
+func closedChan() chan struct{} {
+	c := make(chan struct{})
+	close(c)
+	return c
+}
+
+// SetMetered is called by the app when the device moves from or to a metered
+// connection such as cellular. While metered the neutrino download rate is
+// capped and the compact filters fetching of the sync job is paused.
+func SetMetered(isMetered bool) {
+	meteredMu.Lock()
+	defer meteredMu.Unlock()
+	if metered == isMetered {
+		return
+	}
+	metered = isMetered
+	if metered {
+		unmetered = make(chan struct{})
+	} else {
+		close(unmetered)
+	}
+}
+
+// IsMetered returns true if the app reported a metered connection.
+func IsMetered() bool {
+	meteredMu.Lock()
+	defer meteredMu.Unlock()
+	return metered
+}
+
+// WaitUnmetered blocks while the connection is metered. It returns false if
+// quit was closed before.
+func WaitUnmetered(quit <-chan struct{}) bool {
+	meteredMu.Lock()
+	c := unmetered
+	meteredMu.Unlock()
+	select {
+	case <-c:
+		return true
+	case <-quit:
+		return false
+	}
+}
+
+// rateLimiter spreads the reads of all the peers connections so they don't
+// exceed the rate, in bytes per second.
+type rateLimiter struct {
+	mu   sync.Mutex
+	rate int64
+	next time.Time
+}
+
+func (l *rateLimiter) setRate(rate int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rate = rate
+}
+
+func (l *rateLimiter) wait(n int) {
+	if n <= 0 || !IsMetered() {
+		return
+	}
+	l.mu.Lock()
+	if l.rate <= 0 {
+		l.mu.Unlock()
+		return
+	}
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	l.next = l.next.Add(time.Duration(int64(n) * int64(time.Second) / l.rate))
+	delay := l.next.Sub(now)
+	l.mu.Unlock()
+	time.Sleep(delay)
+}
+
+type limitedConn struct {
+	net.Conn
+	limiter *rateLimiter
+}
+
+func (c *limitedConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.limiter.wait(n)
+	return n, err
+}
+
+// limitDialer wraps the neutrino dialer so its connections are rate limited
+// while the connection is metered.
+func limitDialer(dial func(net.Addr) (net.Conn, error)) func(net.Addr) (net.Conn, error) {
+	return func(addr net.Addr) (net.Conn, error) {
+		conn, err := dial(addr)
+		if err != nil {
+			return nil, err
+		}
+		return &limitedConn{Conn: conn, limiter: downloadLimit}, nil
+	}
+}
@@ -0,0 +1,224 @@
+package chainservice
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/breez/db"
+	"github.com/btcsuite/btclog"
+	"github.com/lightningnetwork/lnd/tor"
+)
+
+const (
+	// defaultAutoBanDuration is the duration of the bans of the peers
+	// neutrino finds misbehaving, unless neutrinoautobanduration is set.
+	// It is short since the wallet usually has a single peer.
+	defaultAutoBanDuration = 10 * time.Minute
+
+	// neutrinoBanFormat is the format of the message neutrino logs when it
+	// bans a peer.
+	neutrinoBanFormat = "Banning peer %v: duration=%v, reason=%v"
+)
+
+var (
+	bansMu sync.Mutex
+	bans   = make(map[string]*data.PeerBan)
+	bansDB *db.DB
+
+	// autoBanDuration is the duration of the bans neutrino makes and
+	// trustedHosts are the configured and Breez peers, which are never
+	// banned automatically.
+	autoBanDuration = defaultAutoBanDuration
+	trustedHosts    = make(map[string]struct{})
+)
+
+// banLogger is the logger given to neutrino. It persists the bans neutrino
+// makes of misbehaving peers, since neutrino doesn't let us know about them
+// otherwise.
+type banLogger struct {
+	btclog.Logger
+}
+
+func (l *banLogger) Warnf(format string, params ...interface{}) {
+	l.Logger.Warnf(format, params...)
+	if format == neutrinoBanFormat && len(params) == 3 {
+		go persistNeutrinoBan(fmt.Sprint(params[0]), fmt.Sprint(params[2]))
+	}
+}
+
+func persistNeutrinoBan(peer, reason string) {
+	bansMu.Lock()
+	breezDB := bansDB
+	duration := autoBanDuration
+	_, trusted := trustedHosts[peerHost(peer)]
+	bansMu.Unlock()
+	if breezDB == nil {
+		return
+	}
+	if trusted {
+		logger.Infof("not banning the configured peer %v: %v", peer, reason)
+		return
+	}
+	if _, err := BanPeer(breezDB, peer, reason, duration); err != nil {
+		logger.Errorf("failed to persist the ban of %v: %v", peer, err)
+	}
+}
+
+// setAutoBanPolicy sets the duration of the bans neutrino makes, or the
+// default if it isn't positive, and the peers that are never banned
+// automatically.
+func setAutoBanPolicy(duration time.Duration, trusted ...[]string) {
+	bansMu.Lock()
+	defer bansMu.Unlock()
+	autoBanDuration = duration
+	if autoBanDuration <= 0 {
+		autoBanDuration = defaultAutoBanDuration
+	}
+	trustedHosts = make(map[string]struct{})
+	for _, peers := range trusted {
+		for _, peer := range peers {
+			trustedHosts[peerHost(peer)] = struct{}{}
+		}
+	}
+}
+
+// peerHost returns the host of a peer given as host or host:port.
+func peerHost(peer string) string {
+	if host, _, err := net.SplitHostPort(peer); err == nil {
+		return host
+	}
+	return peer
+}
+
+// addrHost returns the host neutrino dials, turning the fake IPs neutrino
+// uses for onion peers back to their onion host.
+func addrHost(addr net.Addr) string {
+	if tor.IsOnionFakeIP(addr) {
+		if onion, err := tor.FakeIPToOnionHost(addr); err == nil {
+			return peerHost(onion.String())
+		}
+	}
+	return peerHost(addr.String())
+}
+
+func banExpired(ban *data.PeerBan, now time.Time) bool {
+	return ban.ExpiresAt != 0 && ban.ExpiresAt <= now.Unix()
+}
+
+// loadPeerBans loads the bans stored in breezDB, deleting the expired ones.
+func loadPeerBans(breezDB *db.DB) error {
+	stored, err := breezDB.FetchPeerBans()
+	if err != nil {
+		return err
+	}
+	bansMu.Lock()
+	defer bansMu.Unlock()
+	bansDB = breezDB
+	bans = make(map[string]*data.PeerBan)
+	now := time.Now()
+	for _, ban := range stored {
+		if banExpired(ban, now) {
+			if err := breezDB.DeletePeerBan(ban.Host); err != nil {
+				return err
+			}
+			continue
+		}
+		bans[ban.Host] = ban
+	}
+	return nil
+}
+
+func isBanned(host string) bool {
+	bansMu.Lock()
+	defer bansMu.Unlock()
+	ban, ok := bans[host]
+	return ok && !banExpired(ban, time.Now())
+}
+
+// refuseBanned wraps the neutrino dialer so it doesn't connect to banned
+// peers.
+func refuseBanned(dial func(net.Addr) (net.Conn, error)) func(net.Addr) (net.Conn, error) {
+	return func(addr net.Addr) (net.Conn, error) {
+		if isBanned(addrHost(addr)) {
+			return nil, fmt.Errorf("peer %v is banned", addr)
+		}
+		return dial(addr)
+	}
+}
+
+/*
+BanPeer bans the chain peer for the duration, or until it is unbanned if the
+duration is 0, and disconnects it. The peer is given as host or host:port and
+all the peers on this host are banned. The ban is persisted so it survives
+restarts.
+*/
+func BanPeer(breezDB *db.DB, peer, reason string, duration time.Duration) (*data.PeerBan, error) {
+	host := peerHost(peer)
+	if host == "" {
+		return nil, fmt.Errorf("invalid peer %v", peer)
+	}
+	now := time.Now()
+	ban := &data.PeerBan{
+		Host:     host,
+		Reason:   reason,
+		BannedAt: now.Unix(),
+	}
+	if duration > 0 {
+		ban.ExpiresAt = now.Add(duration).Unix()
+	}
+	if err := breezDB.SavePeerBan(ban); err != nil {
+		return nil, err
+	}
+	bansMu.Lock()
+	bans[host] = ban
+	bansMu.Unlock()
+
+	bootstrapMu.Lock()
+	cs := service
+	bootstrapMu.Unlock()
+	if cs != nil && cs.IsStarted() {
+		for _, p := range cs.Peers() {
+			if peerHost(p.Addr()) == host {
+				logger.Infof("disconnecting banned peer %v", p.Addr())
+				p.Disconnect()
+			}
+		}
+	}
+	return ban, nil
+}
+
+// UnbanPeer removes the ban of the chain peer host.
+func UnbanPeer(breezDB *db.DB, peer string) error {
+	host := peerHost(peer)
+	if err := breezDB.DeletePeerBan(host); err != nil {
+		return err
+	}
+	bansMu.Lock()
+	delete(bans, host)
+	bansMu.Unlock()
+	return nil
+}
+
+// PeerBans returns the chain peers bans that didn't expire, the most recent
+// first.
+func PeerBans(breezDB *db.DB) ([]*data.PeerBan, error) {
+	stored, err := breezDB.FetchPeerBans()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var active []*data.PeerBan
+	for _, ban := range stored {
+		if !banExpired(ban, now) {
+			active = append(active, ban)
+		}
+	}
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].BannedAt > active[j].BannedAt
+	})
+	return active, nil
+}
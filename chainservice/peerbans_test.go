@@ -0,0 +1,65 @@
+package chainservice
+
+import (
+	"io/ioutil"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/breez/breez/db"
+	"github.com/btcsuite/btclog"
+)
+
+func TestPersistNeutrinoBan(t *testing.T) {
+	if logger == nil {
+		logger = btclog.Disabled
+	}
+	dir := t.TempDir()
+	conf := []byte("[Application Options]\nnetwork=simnet\n")
+	if err := ioutil.WriteFile(path.Join(dir, "breez.conf"), conf, 0600); err != nil {
+		t.Fatalf("ioutil.WriteFile: %v", err)
+	}
+	breezDB, cleanup, err := db.Get(dir)
+	if err != nil {
+		t.Fatalf("db.Get: %v", err)
+	}
+	defer cleanup()
+	if err := loadPeerBans(breezDB); err != nil {
+		t.Fatalf("loadPeerBans: %v", err)
+	}
+
+	setAutoBanPolicy(time.Hour, []string{"10.0.0.2:8333"})
+	defer setAutoBanPolicy(0)
+
+	persistNeutrinoBan("10.0.0.2:8333", "peer sent invalid filter header")
+	l := &banLogger{Logger: btclog.Disabled}
+	l.Warnf(neutrinoBanFormat, "10.0.0.1:8333", time.Second, "peer sent invalid filter header")
+	deadline := time.Now().Add(5 * time.Second)
+	for !isBanned("10.0.0.1") {
+		if time.Now().After(deadline) {
+			t.Fatalf("the neutrino ban wasn't recorded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// The ban survives a restart.
+	if err := loadPeerBans(breezDB); err != nil {
+		t.Fatalf("loadPeerBans: %v", err)
+	}
+	stored, err := PeerBans(breezDB)
+	if err != nil {
+		t.Fatalf("PeerBans: %v", err)
+	}
+	if len(stored) != 1 || stored[0].Host != "10.0.0.1" || stored[0].Reason != "peer sent invalid filter header" {
+		t.Fatalf("PeerBans = %v", stored)
+	}
+	expires := time.Unix(stored[0].ExpiresAt, 0)
+	if expires.Before(time.Now().Add(59*time.Minute)) || expires.After(time.Now().Add(time.Hour)) {
+		t.Fatalf("ban expires at %v, want about an hour from now", expires)
+	}
+
+	// The configured peers are not banned automatically.
+	if isBanned("10.0.0.2") {
+		t.Fatalf("the configured peer was banned")
+	}
+}
@@ -0,0 +1,191 @@
+package chainservice
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/breez/breez/config"
+	"github.com/lightninglabs/neutrino"
+)
+
+const (
+	defaultMaxPeers         = 1
+	defaultPeerStallTimeout = 3 * time.Minute
+	peerCheckInterval       = 30 * time.Second
+)
+
+// PeerHealth holds the responsiveness metrics of a connected neutrino peer.
+type PeerHealth struct {
+	Addr       string
+	PingMicros int64
+	LastRecv   time.Time
+	Height     int32
+
+	// Stalls is the number of times the peer was disconnected for not
+	// responding or not serving headers.
+	Stalls int
+}
+
+// peerMonitor disconnects the peers that stop responding or stall the header
+// sync, so the chain service connects to other peers or reconnects.
+type peerMonitor struct {
+	cs           *neutrino.ChainService
+	stallTimeout time.Duration
+
+	mu           sync.Mutex
+	stalls       map[string]int
+	bestHeight   int32
+	lastProgress time.Time
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+var (
+	monitorMu sync.Mutex
+	monitor   *peerMonitor
+)
+
+func maxPeers(cfg config.NeutrinoConfig) int {
+	if cfg.MaxPeers > 0 {
+		return cfg.MaxPeers
+	}
+	return defaultMaxPeers
+}
+
+func newPeerMonitor(cs *neutrino.ChainService, cfg config.NeutrinoConfig) *peerMonitor {
+	stallTimeout := cfg.PeerStallTimeout
+	if stallTimeout <= 0 {
+		stallTimeout = defaultPeerStallTimeout
+	}
+	return &peerMonitor{
+		cs:           cs,
+		stallTimeout: stallTimeout,
+		stalls:       make(map[string]int),
+		lastProgress: time.Now(),
+		quit:         make(chan struct{}),
+	}
+}
+
+func startPeerMonitor(cs *neutrino.ChainService, cfg config.NeutrinoConfig) {
+	monitorMu.Lock()
+	defer monitorMu.Unlock()
+	monitor = newPeerMonitor(cs, cfg)
+	monitor.start()
+}
+
+func stopPeerMonitor() {
+	monitorMu.Lock()
+	m := monitor
+	monitor = nil
+	monitorMu.Unlock()
+	if m != nil {
+		m.stop()
+	}
+}
+
+func (m *peerMonitor) start() {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(peerCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.check(time.Now())
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+func (m *peerMonitor) stop() {
+	close(m.quit)
+	m.wg.Wait()
+}
+
+// check disconnects the peers that didn't send anything for the stall
+// timeout. If the headers didn't progress for the stall timeout while peers
+// are ahead of us, the least responsive peer is disconnected as well.
+func (m *peerMonitor) check(now time.Time) {
+	if !m.cs.IsStarted() {
+		return
+	}
+	best, err := m.cs.BestBlock()
+	if err != nil {
+		logger.Errorf("peerMonitor: failed to get the best block %v", err)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if best.Height > m.bestHeight {
+		m.bestHeight = best.Height
+		m.lastProgress = now
+	}
+
+	peers := m.cs.Peers()
+	var responsive []*neutrino.ServerPeer
+	var behind bool
+	for _, p := range peers {
+		if now.Sub(p.LastRecv()) > m.stallTimeout {
+			logger.Infof("peerMonitor: disconnecting unresponsive peer %v", p.Addr())
+			m.stalls[p.Addr()]++
+			p.Disconnect()
+			continue
+		}
+		responsive = append(responsive, p)
+		if p.LastBlock() > best.Height {
+			behind = true
+		}
+	}
+
+	if !behind || len(responsive) == 0 || now.Sub(m.lastProgress) < m.stallTimeout {
+		return
+	}
+	sort.Slice(responsive, func(i, j int) bool {
+		return m.peerScore(responsive[i]) < m.peerScore(responsive[j])
+	})
+	worst := responsive[0]
+	logger.Infof("peerMonitor: header sync stalled at %v, disconnecting peer %v", best.Height, worst.Addr())
+	m.stalls[worst.Addr()]++
+	m.lastProgress = now
+	worst.Disconnect()
+}
+
+// peerScore ranks the peers, the lower the worse. Peers that stalled before
+// rank lower, then the ones with the highest ping.
+func (m *peerMonitor) peerScore(p *neutrino.ServerPeer) int64 {
+	return -int64(m.stalls[p.Addr()])*int64(time.Minute/time.Microsecond) - p.LastPingMicros()
+}
+
+func (m *peerMonitor) peersHealth() []PeerHealth {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var health []PeerHealth
+	for _, p := range m.cs.Peers() {
+		health = append(health, PeerHealth{
+			Addr:       p.Addr(),
+			PingMicros: p.LastPingMicros(),
+			LastRecv:   p.LastRecv(),
+			Height:     p.LastBlock(),
+			Stalls:     m.stalls[p.Addr()],
+		})
+	}
+	return health
+}
+
+// PeersHealth returns the metrics of the peers the chain service is connected
+// to, or nil if it isn't running.
+func PeersHealth() []PeerHealth {
+	monitorMu.Lock()
+	m := monitor
+	monitorMu.Unlock()
+	if m == nil {
+		return nil
+	}
+	return m.peersHealth()
+}
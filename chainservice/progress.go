@@ -0,0 +1,57 @@
+package chainservice
+
+import "fmt"
+
+// SyncProgress describes how far the chain service is in syncing the block
+// headers and the filter headers.
+type SyncProgress struct {
+	HeaderHeight       int32
+	FilterHeaderHeight int32
+
+	// TargetHeight is the best height advertised by the connected peers.
+	TargetHeight int32
+	Peers        int32
+
+	// Percent is the estimated progress of the headers and filter headers
+	// sync, both weighted equally.
+	Percent int32
+}
+
+// GetSyncProgress returns the sync progress of the running chain service.
+func GetSyncProgress() (*SyncProgress, error) {
+	bootstrapMu.Lock()
+	cs := service
+	bootstrapMu.Unlock()
+	if cs == nil || !cs.IsStarted() {
+		return nil, fmt.Errorf("chain service is not running")
+	}
+
+	best, err := cs.BestBlock()
+	if err != nil {
+		return nil, err
+	}
+	_, filterHeight, err := cs.RegFilterHeaders.ChainTip()
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &SyncProgress{
+		HeaderHeight:       best.Height,
+		FilterHeaderHeight: int32(filterHeight),
+		TargetHeight:       best.Height,
+		Peers:              cs.ConnectedCount(),
+	}
+	for _, p := range cs.Peers() {
+		if p.LastBlock() > progress.TargetHeight {
+			progress.TargetHeight = p.LastBlock()
+		}
+	}
+	if progress.TargetHeight > 0 {
+		progress.Percent = int32(int64(progress.HeaderHeight+progress.FilterHeaderHeight) * 100 /
+			int64(2*progress.TargetHeight))
+	}
+	if progress.Percent > 100 {
+		progress.Percent = 100
+	}
+	return progress, nil
+}
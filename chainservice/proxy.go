@@ -0,0 +1,68 @@
+package chainservice
+
+import (
+	"net"
+	"time"
+
+	"github.com/breez/breez/config"
+	"github.com/lightningnetwork/lnd/tor"
+)
+
+const (
+	dialTimeout = 30 * time.Second
+)
+
+// dialer returns the function neutrino connects to the peers with. When a
+// proxy is configured all the connections go through it, including the ones
+// to onion peers.
+func dialer(cfg config.NeutrinoConfig) func(net.Addr) (net.Conn, error) {
+	if cfg.Proxy == "" {
+		return func(addr net.Addr) (net.Conn, error) {
+			return net.Dial(addr.Network(), addr.String())
+		}
+	}
+	return func(addr net.Addr) (net.Conn, error) {
+		dialAddr := addr
+		if tor.IsOnionFakeIP(addr) {
+			// Neutrino only knows IP addresses, so onion peers are
+			// given to it as fake IPv6 addresses.
+			var err error
+			dialAddr, err = tor.FakeIPToOnionHost(addr)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return tor.Dial(dialAddr.String(), cfg.Proxy, cfg.ProxyStreamIsolation, dialTimeout)
+	}
+}
+
+// nameResolver returns the function neutrino resolves the peers hosts with.
+// When a proxy is configured the hosts are resolved through it so no DNS
+// query leaves the device, and onion v2 hosts are turned into fake IPv6
+// addresses. Onion v3 hosts can't be represented this way.
+func nameResolver(cfg config.NeutrinoConfig) func(string) ([]net.IP, error) {
+	if cfg.Proxy == "" {
+		return net.LookupIP
+	}
+	return func(host string) ([]net.IP, error) {
+		if tor.IsOnionHost(host) {
+			fakeIP, err := tor.OnionHostToFakeIP(host)
+			if err != nil {
+				return nil, err
+			}
+			return []net.IP{fakeIP}, nil
+		}
+
+		addrs, err := tor.LookupHost(host, cfg.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		ips := make([]net.IP, 0, len(addrs))
+		for _, addr := range addrs {
+			if ip := net.ParseIP(addr); ip != nil {
+				ips = append(ips, ip)
+			}
+		}
+		return ips, nil
+	}
+}
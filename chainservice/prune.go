@@ -0,0 +1,200 @@
+package chainservice
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"time"
+
+	"github.com/breez/breez/config"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightninglabs/neutrino/headerfs"
+)
+
+/*
+pruneBeforeBirthday drops from the neutrino headers files the headers of the
+blocks mined before the checkpoint preceding the wallet birthday block. Only
+the headers at the checkpoint interval are kept, as ensureMinimumTip writes
+them when bootstrapping, and the files are rewritten as sparse files so the
+dropped headers don't take disk space. Those blocks can't hold wallet
+transactions so their headers are not needed.
+*/
+func pruneBeforeBirthday(workingDir string) error {
+	config, err := config.GetConfig(workingDir)
+	if err != nil {
+		return err
+	}
+	if !config.PruneCfg.NeutrinoBeforeBirthday {
+		return nil
+	}
+	neutrinoDataDir := neutrinoDataDir(workingDir, config.Network)
+	neutrinoDB := path.Join(neutrinoDataDir, "neutrino.db")
+	if _, err := os.Stat(neutrinoDB); os.IsNotExist(err) {
+		return nil
+	}
+	birthdayHeight, err := walletBirthdayHeight(workingDir)
+	if err != nil || birthdayHeight == 0 {
+		return err
+	}
+	cutoff := birthdayHeight - birthdayHeight%wire.CFCheckptInterval
+
+	db, err := walletdb.Open("bdb", neutrinoDB, false, time.Second*60)
+	if err != nil {
+		return fmt.Errorf("failed to open neutrino.db: %w", err)
+	}
+	defer db.Close()
+	pruned, err := pruneHeaders(db, neutrinoDataDir, cutoff)
+	if pruned {
+		logger.Infof("pruned the neutrino headers below height %v", cutoff)
+	}
+	return err
+}
+
+/*
+pruneHeaders keeps only the headers at the checkpoint interval below cutoff,
+which must be a multiple of the interval, and removes the index entries of the
+other ones. It returns false if they were already pruned or if the headers
+don't reach one checkpoint interval past cutoff yet.
+*/
+func pruneHeaders(db walletdb.DB, neutrinoDataDir string, cutoff uint32) (bool, error) {
+	if cutoff == 0 {
+		return false, nil
+	}
+	_, filterTip, err := indexTips(db)
+	if err != nil {
+		return false, err
+	}
+	// The filter headers tip is never ahead of the block headers tip.
+	if cutoff+wire.CFCheckptInterval > filterTip.height {
+		return false, nil
+	}
+	headersPath := path.Join(neutrinoDataDir, blockHeadersFile)
+	pruned, err := headerPruned(headersPath, cutoff-1)
+	if err != nil || pruned {
+		return false, err
+	}
+
+	// The index is pruned first so it never points to a dropped header.
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		return deleteIndexEntries(tx.ReadWriteBucket(headerIndexBucket), func(height uint32) bool {
+			return height < cutoff && height%wire.CFCheckptInterval != 0
+		})
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if err := sparsifyHeaders(headersPath, headerfs.BlockHeaderSize, cutoff); err != nil {
+		return false, err
+	}
+	filterHeadersPath := path.Join(neutrinoDataDir, filterHeadersFile)
+	if err := sparsifyHeaders(filterHeadersPath, headerfs.RegularFilterHeaderSize, cutoff); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// headerPruned returns true if the block header at height was dropped.
+func headerPruned(headersPath string, height uint32) (bool, error) {
+	f, err := os.Open(headersPath)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	raw := make([]byte, headerfs.BlockHeaderSize)
+	if _, err := f.ReadAt(raw, int64(height)*headerfs.BlockHeaderSize); err != nil {
+		return false, err
+	}
+	return bytes.Equal(raw, make([]byte, headerfs.BlockHeaderSize)), nil
+}
+
+// sparsifyHeaders rewrites the headers file keeping only the headers at the
+// checkpoint interval below cutoff. The other ones below cutoff are left as
+// holes.
+func sparsifyHeaders(headersPath string, headerSize int, cutoff uint32) error {
+	src, err := os.Open(headersPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+
+	tmpPath := headersPath + ".tmp"
+	dst, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	err = func() error {
+		defer dst.Close()
+		header := make([]byte, headerSize)
+		for height := uint32(0); height < cutoff; height += wire.CFCheckptInterval {
+			offset := int64(height) * int64(headerSize)
+			if _, err := src.ReadAt(header, offset); err != nil {
+				return err
+			}
+			if _, err := dst.WriteAt(header, offset); err != nil {
+				return err
+			}
+		}
+		offset := int64(cutoff) * int64(headerSize)
+		if _, err := dst.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+		if _, err := io.Copy(dst, io.NewSectionReader(src, offset, info.Size()-offset)); err != nil {
+			return err
+		}
+		return dst.Sync()
+	}()
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return os.Rename(tmpPath, headersPath)
+}
+
+// walletBirthdayHeight returns the height of the wallet birthday block, or 0
+// if the wallet doesn't exist or didn't set it yet.
+func walletBirthdayHeight(workingDir string) (uint32, error) {
+	config, err := config.GetConfig(workingDir)
+	if err != nil {
+		return 0, err
+	}
+	walletDBPath := path.Join(workingDir, "data/chain/bitcoin/", config.Network, "wallet.db")
+	if _, err = os.Stat(walletDBPath); err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	db, err := walletdb.Open("bdb", walletDBPath, false, time.Second*60)
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	var height uint32
+	err = walletdb.View(db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespace)
+		if ns == nil {
+			return nil
+		}
+		syncBucket := ns.NestedReadBucket(syncBucketName)
+		if syncBucket == nil {
+			return nil
+		}
+		// The birthday block is stored as height, hash and timestamp.
+		if birthdayBlock := syncBucket.Get(birthdayHeightKey); len(birthdayBlock) == 44 {
+			height = binary.BigEndian.Uint32(birthdayBlock[:4])
+		}
+		return nil
+	})
+	return height, err
+}
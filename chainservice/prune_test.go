@@ -0,0 +1,87 @@
+package chainservice
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/neutrino/headerfs"
+)
+
+func TestPruneHeaders(t *testing.T) {
+	if logger == nil {
+		logger = btclog.Disabled
+	}
+	dir, err := ioutil.TempDir("", "neutrino")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	params := &chaincfg.RegressionNetParams
+	headers := regtestHeaders(2500, -1)
+	db := syncedNeutrino(t, dir, headers)
+	defer db.Close()
+	headersPath := path.Join(dir, blockHeadersFile)
+	blockHeaders, err := ioutil.ReadFile(headersPath)
+	if err != nil {
+		t.Fatalf("ioutil.ReadFile: %v", err)
+	}
+	filterHeadersPath := path.Join(dir, filterHeadersFile)
+	filterHeaders, err := ioutil.ReadFile(filterHeadersPath)
+	if err != nil {
+		t.Fatalf("ioutil.ReadFile: %v", err)
+	}
+
+	pruned, err := pruneHeaders(db, dir, 1000)
+	if err != nil || !pruned {
+		t.Fatalf("pruneHeaders = %v, %v", pruned, err)
+	}
+	if err := checkNeutrinoFiles(db, dir, params); err != nil {
+		t.Fatalf("checkNeutrinoFiles after pruning: %v", err)
+	}
+
+	raw, err := ioutil.ReadFile(headersPath)
+	if err != nil {
+		t.Fatalf("ioutil.ReadFile: %v", err)
+	}
+	rawFilters, err := ioutil.ReadFile(filterHeadersPath)
+	if err != nil {
+		t.Fatalf("ioutil.ReadFile: %v", err)
+	}
+	for _, height := range []int{0, 500, 999, 1000, 2500} {
+		kept := height%1000 == 0 || height >= 1000
+		blockHeader := raw[height*headerfs.BlockHeaderSize : (height+1)*headerfs.BlockHeaderSize]
+		filterHeader := rawFilters[height*headerfs.RegularFilterHeaderSize : (height+1)*headerfs.RegularFilterHeaderSize]
+		wantBlock := blockHeaders[height*headerfs.BlockHeaderSize : (height+1)*headerfs.BlockHeaderSize]
+		wantFilter := filterHeaders[height*headerfs.RegularFilterHeaderSize : (height+1)*headerfs.RegularFilterHeaderSize]
+		if !kept {
+			wantBlock = make([]byte, headerfs.BlockHeaderSize)
+			wantFilter = make([]byte, headerfs.RegularFilterHeaderSize)
+		}
+		if !bytes.Equal(blockHeader, wantBlock) || !bytes.Equal(filterHeader, wantFilter) {
+			t.Fatalf("headers at height %v: kept = %v, want %v", height, !kept, kept)
+		}
+	}
+
+	store, err := headerfs.NewBlockHeaderStore(dir, db, params)
+	if err != nil {
+		t.Fatalf("headerfs.NewBlockHeaderStore: %v", err)
+	}
+	hash := headers[500].BlockHash()
+	if _, _, err := store.FetchHeader(&hash); err == nil {
+		t.Fatalf("the pruned header at height 500 is still indexed")
+	}
+	hash = headers[1000].BlockHash()
+	if _, height, err := store.FetchHeader(&hash); err != nil || height != 1000 {
+		t.Fatalf("FetchHeader at height 1000 = %v, %v", height, err)
+	}
+
+	pruned, err = pruneHeaders(db, dir, 1000)
+	if err != nil || pruned {
+		t.Fatalf("second pruneHeaders = %v, %v", pruned, err)
+	}
+}
@@ -0,0 +1,62 @@
+package chainservice
+
+import (
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/neutrino"
+	"github.com/lightninglabs/neutrino/blockntfns"
+)
+
+// BlockStamp identifies a block by its hash and height.
+type BlockStamp struct {
+	Hash   chainhash.Hash
+	Height uint32
+}
+
+// Reorg describes a chain reorganization: the tip before it, the tip of the
+// new chain and the number of blocks that were disconnected.
+type Reorg struct {
+	OldTip BlockStamp
+	NewTip BlockStamp
+	Depth  uint32
+}
+
+// ReorgDetector follows the block notifications of the chain service and
+// reports the reorganizations.
+type ReorgDetector struct {
+	oldTip *BlockStamp
+	depth  uint32
+}
+
+/*
+Process handles a block notification and returns the reorg once the new chain
+is connected up to the height of the old tip, or nil.
+*/
+func (r *ReorgDetector) Process(ntfn blockntfns.BlockNtfn) *Reorg {
+	header := ntfn.Header()
+	switch ntfn.(type) {
+	case *blockntfns.Disconnected:
+		if r.oldTip == nil {
+			r.oldTip = &BlockStamp{Hash: header.BlockHash(), Height: ntfn.Height()}
+		}
+		r.depth++
+	case *blockntfns.Connected:
+		if r.oldTip == nil || ntfn.Height() < r.oldTip.Height {
+			return nil
+		}
+		reorg := &Reorg{
+			OldTip: *r.oldTip,
+			NewTip: BlockStamp{Hash: header.BlockHash(), Height: ntfn.Height()},
+			Depth:  r.depth,
+		}
+		r.oldTip = nil
+		r.depth = 0
+		return reorg
+	}
+	return nil
+}
+
+// SubscribeBlocks returns a subscription to the blocks connected and
+// disconnected by the chain service from now on.
+func SubscribeBlocks(cs *neutrino.ChainService) (*blockntfns.Subscription, error) {
+	return (&neutrino.RescanChainSource{ChainService: cs}).Subscribe(0)
+}
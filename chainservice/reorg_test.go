@@ -0,0 +1,51 @@
+package chainservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/neutrino/blockntfns"
+)
+
+func testHeader(nonce uint32) wire.BlockHeader {
+	return wire.BlockHeader{Nonce: nonce, Timestamp: time.Unix(int64(nonce), 0)}
+}
+
+func TestReorgDetector(t *testing.T) {
+	var d ReorgDetector
+	if r := d.Process(blockntfns.NewBlockConnected(testHeader(100), 100)); r != nil {
+		t.Fatalf("unexpected reorg %+v", r)
+	}
+
+	oldTip := testHeader(101)
+	ntfns := []blockntfns.BlockNtfn{
+		blockntfns.NewBlockDisconnected(oldTip, 101, testHeader(100)),
+		blockntfns.NewBlockDisconnected(testHeader(100), 100, testHeader(99)),
+		blockntfns.NewBlockConnected(testHeader(200), 100),
+	}
+	for _, n := range ntfns {
+		if r := d.Process(n); r != nil {
+			t.Fatalf("reorg reported before the new chain reached the old tip: %+v", r)
+		}
+	}
+
+	newTip := testHeader(201)
+	r := d.Process(blockntfns.NewBlockConnected(newTip, 101))
+	if r == nil {
+		t.Fatal("expected a reorg")
+	}
+	if r.OldTip.Hash != oldTip.BlockHash() || r.OldTip.Height != 101 {
+		t.Errorf("unexpected old tip %+v", r.OldTip)
+	}
+	if r.NewTip.Hash != newTip.BlockHash() || r.NewTip.Height != 101 {
+		t.Errorf("unexpected new tip %+v", r.NewTip)
+	}
+	if r.Depth != 2 {
+		t.Errorf("expected depth 2, got %v", r.Depth)
+	}
+
+	if r := d.Process(blockntfns.NewBlockConnected(testHeader(202), 102)); r != nil {
+		t.Fatalf("unexpected reorg %+v", r)
+	}
+}
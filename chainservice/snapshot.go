@@ -0,0 +1,399 @@
+package chainservice
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"time"
+
+	"github.com/breez/breez/config"
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightninglabs/neutrino/headerfs"
+)
+
+const (
+	blockHeadersFile       = "block_headers.bin"
+	filterHeadersFile      = "reg_filter_headers.bin"
+	snapshotSignatureFile  = "snapshot.sig"
+	snapshotDownloadSuffix = ".snapshot"
+	snapshotBackupSuffix   = ".backup"
+	snapshotTimeout        = 5 * time.Minute
+)
+
+/*
+bootstrapFromSnapshot downloads the block headers and filter headers snapshot
+of the network, verifies it was signed by the configured key, that the headers
+are chained from the genesis block, match all the known checkpoints and have
+the required proof of work after the last one, and then replaces the neutrino
+headers files and index with it.
+It returns the snapshot tip height.
+*/
+func bootstrapFromSnapshot(db walletdb.DB, neutrinoDataDir string,
+	cfg *config.Config, minHeight uint32) (uint32, error) {
+
+	params, err := chainParams(cfg.Network)
+	if err != nil {
+		return 0, err
+	}
+	pubKeyBytes, err := hex.DecodeString(cfg.SnapshotCfg.PubKey)
+	if err != nil {
+		return 0, fmt.Errorf("invalid snapshot public key: %w", err)
+	}
+	pubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+	if err != nil {
+		return 0, fmt.Errorf("invalid snapshot public key: %w", err)
+	}
+
+	headersPath := path.Join(neutrinoDataDir, blockHeadersFile+snapshotDownloadSuffix)
+	filterHeadersPath := path.Join(neutrinoDataDir, filterHeadersFile+snapshotDownloadSuffix)
+	defer os.Remove(headersPath)
+	defer os.Remove(filterHeadersPath)
+
+	headersHash, err := downloadSnapshotFile(cfg, blockHeadersFile, headersPath)
+	if err != nil {
+		return 0, err
+	}
+	filterHeadersHash, err := downloadSnapshotFile(cfg, filterHeadersFile, filterHeadersPath)
+	if err != nil {
+		return 0, err
+	}
+	sig, err := fetchSnapshotSignature(cfg)
+	if err != nil {
+		return 0, err
+	}
+	digest := sha256.Sum256(append(headersHash, filterHeadersHash...))
+	signature, err := btcec.ParseDERSignature(sig, btcec.S256())
+	if err != nil {
+		return 0, fmt.Errorf("invalid snapshot signature: %w", err)
+	}
+	if !signature.Verify(digest[:], pubKey) {
+		return 0, fmt.Errorf("snapshot signature verification failed")
+	}
+
+	hashes, err := verifySnapshotHeaders(headersPath, params)
+	if err != nil {
+		return 0, err
+	}
+	tipHeight := uint32(len(hashes) - 1)
+	if tipHeight <= minHeight {
+		return 0, fmt.Errorf("snapshot tip %v is not above the current tip %v", tipHeight, minHeight)
+	}
+	if err := verifySnapshotFilterHeaders(filterHeadersPath, tipHeight, params); err != nil {
+		return 0, err
+	}
+
+	if err := installSnapshot(db, neutrinoDataDir, hashes); err != nil {
+		return 0, err
+	}
+	return tipHeight, nil
+}
+
+// installSnapshot swaps the downloaded headers files in and indexes their
+// headers. The current files are kept aside until the index is updated and
+// are restored if any step fails, so neutrino never sees half a snapshot.
+func installSnapshot(db walletdb.DB, neutrinoDataDir string, hashes []chainhash.Hash) error {
+	var swapped []string
+	restore := func() {
+		for _, current := range swapped {
+			if err := os.Rename(current+snapshotBackupSuffix, current); err != nil {
+				os.Remove(current)
+			}
+		}
+	}
+	for _, name := range []string{blockHeadersFile, filterHeadersFile} {
+		current := path.Join(neutrinoDataDir, name)
+		if err := os.Rename(current, current+snapshotBackupSuffix); err != nil && !os.IsNotExist(err) {
+			restore()
+			return err
+		}
+		swapped = append(swapped, current)
+		if err := os.Rename(current+snapshotDownloadSuffix, current); err != nil {
+			restore()
+			return err
+		}
+	}
+	if err := indexSnapshotHeaders(db, hashes); err != nil {
+		restore()
+		return err
+	}
+	for _, current := range swapped {
+		os.Remove(current + snapshotBackupSuffix)
+	}
+	return nil
+}
+
+func snapshotURL(cfg *config.Config, filename string) (string, error) {
+	u, err := url.Parse(cfg.SnapshotCfg.URL)
+	if err != nil {
+		return "", fmt.Errorf("url.Parse(%v): %w", cfg.SnapshotCfg.URL, err)
+	}
+	u.Path = path.Join(u.Path, cfg.Network, filename)
+	return u.String(), nil
+}
+
+func getSnapshot(cfg *config.Config, filename string) (*http.Response, error) {
+	u, err := snapshotURL(cfg, filename)
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof("downloading snapshot file %v", u)
+	client := &http.Client{Timeout: snapshotTimeout}
+	resp, err := client.Get(u)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to download %v: %v", u, resp.Status)
+	}
+	return resp, nil
+}
+
+// downloadSnapshotFile downloads a snapshot file to dest and returns its
+// sha256 hash.
+func downloadSnapshotFile(cfg *config.Config, filename, dest string) ([]byte, error) {
+	resp, err := getSnapshot(cfg, filename)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	f, err := os.OpenFile(dest, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(f, h), resp.Body); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), f.Sync()
+}
+
+func fetchSnapshotSignature(cfg *config.Config) ([]byte, error) {
+	resp, err := getSnapshot(cfg, snapshotSignatureFile)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+}
+
+// verifySnapshotHeaders checks that the headers start at the genesis block,
+// are chained, match the checkpoints and that the ones after the last
+// checkpoint have the required proof of work. It returns the headers hashes.
+func verifySnapshotHeaders(headersPath string, params *chaincfg.Params) ([]chainhash.Hash, error) {
+	f, err := os.Open(headersPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var hashes []chainhash.Hash
+	var times []uint32
+	var prevBits uint32
+	lastCheckpoint := lastCheckpointHeight(params)
+	r := bufio.NewReader(f)
+	raw := make([]byte, headerfs.BlockHeaderSize)
+	for height := uint32(0); ; height++ {
+		if _, err := io.ReadFull(r, raw); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("truncated block header at height %v: %w", height, err)
+		}
+		var header wire.BlockHeader
+		if err := header.Deserialize(bytes.NewReader(raw)); err != nil {
+			return nil, err
+		}
+		blockHash := header.BlockHash()
+		if height == 0 {
+			if blockHash != *params.GenesisHash {
+				return nil, fmt.Errorf("snapshot doesn't start at the genesis block")
+			}
+		} else if header.PrevBlock != hashes[height-1] {
+			return nil, fmt.Errorf("block header at height %v is not chained", height)
+		}
+		if err := verifyHeaderCheckpoint(height, blockHash, raw, params); err != nil {
+			return nil, err
+		}
+		if height > lastCheckpoint {
+			if err := verifyHeaderWork(&header, height, prevBits, times, params); err != nil {
+				return nil, err
+			}
+		}
+		hashes = append(hashes, blockHash)
+		times = append(times, uint32(header.Timestamp.Unix()))
+		prevBits = header.Bits
+	}
+	if len(hashes) == 0 {
+		return nil, fmt.Errorf("empty block headers snapshot")
+	}
+
+	tip := uint32(len(hashes) - 1)
+	if shipped := shippedFilterHeader(params.Name); shipped != nil && tip < shipped.Height {
+		return nil, fmt.Errorf("snapshot tip %v is below the last checkpoint %v", tip, shipped.Height)
+	}
+	return hashes, nil
+}
+
+func verifyHeaderCheckpoint(height uint32, blockHash chainhash.Hash, raw []byte,
+	params *chaincfg.Params) error {
+
+	for _, ck := range params.Checkpoints {
+		if uint32(ck.Height) == height && blockHash != *ck.Hash {
+			return fmt.Errorf("block header at height %v doesn't match the checkpoint", height)
+		}
+	}
+	if params.Name != chaincfg.MainNetParams.Name || height%wire.CFCheckptInterval != 0 {
+		return nil
+	}
+	i := int(height / wire.CFCheckptInterval)
+	if i >= len(checkpoints) {
+		return nil
+	}
+	var buf bytes.Buffer
+	if err := checkpoints[i].BlockHeader.Serialize(&buf); err != nil {
+		return err
+	}
+	if !bytes.Equal(buf.Bytes(), raw) {
+		return fmt.Errorf("block header at height %v doesn't match the checkpoint", height)
+	}
+	return nil
+}
+
+// lastCheckpointHeight returns the height of the last header of the network
+// known to the app.
+func lastCheckpointHeight(params *chaincfg.Params) uint32 {
+	var height uint32
+	if n := len(params.Checkpoints); n > 0 {
+		height = uint32(params.Checkpoints[n-1].Height)
+	}
+	if shipped := shippedFilterHeader(params.Name); shipped != nil && shipped.Height > height {
+		height = shipped.Height
+	}
+	if params.Name == chaincfg.MainNetParams.Name && len(checkpoints) > 0 {
+		if last := uint32((len(checkpoints) - 1) * wire.CFCheckptInterval); last > height {
+			height = last
+		}
+	}
+	return height
+}
+
+// verifyHeaderWork checks that the header at height has the difficulty
+// required after the previous headers, whose bits are prevBits and whose
+// timestamps are times, and that its hash meets it.
+func verifyHeaderWork(header *wire.BlockHeader, height, prevBits uint32, times []uint32,
+	params *chaincfg.Params) error {
+
+	block := btcutil.NewBlock(&wire.MsgBlock{Header: *header})
+	if err := blockchain.CheckProofOfWork(block, params.PowLimit); err != nil {
+		return fmt.Errorf("block header at height %v: %w", height, err)
+	}
+
+	// The networks reducing the min difficulty accept min difficulty blocks
+	// between the retargets, so prevBits is not the difficulty the next
+	// headers are based on.
+	if params.ReduceMinDifficulty {
+		return nil
+	}
+
+	blocksPerRetarget := uint32(params.TargetTimespan / params.TargetTimePerBlock)
+	if height%blocksPerRetarget != 0 {
+		if header.Bits != prevBits {
+			return fmt.Errorf("block header at height %v has bits %08x, expected %08x",
+				height, header.Bits, prevBits)
+		}
+		return nil
+	}
+
+	targetTimespan := int64(params.TargetTimespan / time.Second)
+	timespan := int64(times[height-1]) - int64(times[height-blocksPerRetarget])
+	if min := targetTimespan / params.RetargetAdjustmentFactor; timespan < min {
+		timespan = min
+	}
+	if max := targetTimespan * params.RetargetAdjustmentFactor; timespan > max {
+		timespan = max
+	}
+	target := new(big.Int).Mul(blockchain.CompactToBig(prevBits), big.NewInt(timespan))
+	target.Div(target, big.NewInt(targetTimespan))
+	if target.Cmp(params.PowLimit) > 0 {
+		target.Set(params.PowLimit)
+	}
+	if expected := blockchain.BigToCompact(target); header.Bits != expected {
+		return fmt.Errorf("block header at height %v has bits %08x, expected %08x",
+			height, header.Bits, expected)
+	}
+	return nil
+}
+
+// verifySnapshotFilterHeaders checks that there is a filter header for every
+// block header and that they match the checkpoints.
+func verifySnapshotFilterHeaders(filterHeadersPath string, tipHeight uint32,
+	params *chaincfg.Params) error {
+	info, err := os.Stat(filterHeadersPath)
+	if err != nil {
+		return err
+	}
+	if info.Size() != int64(tipHeight+1)*headerfs.RegularFilterHeaderSize {
+		return fmt.Errorf("filter headers snapshot size %v doesn't match the tip %v",
+			info.Size(), tipHeight)
+	}
+	if params.Name != chaincfg.MainNetParams.Name {
+		return nil
+	}
+
+	f, err := os.Open(filterHeadersPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var filterHeader chainhash.Hash
+	for i, ck := range checkpoints {
+		height := uint32(i * wire.CFCheckptInterval)
+		if height > tipHeight {
+			break
+		}
+		if _, err := f.ReadAt(filterHeader[:], int64(height)*headerfs.RegularFilterHeaderSize); err != nil {
+			return err
+		}
+		if filterHeader != *ck.FilterHeader {
+			return fmt.Errorf("filter header at height %v doesn't match the checkpoint", height)
+		}
+	}
+	return nil
+}
+
+// indexSnapshotHeaders adds the snapshot headers to the neutrino headers
+// index and sets its tip in one transaction.
+func indexSnapshotHeaders(db walletdb.DB, hashes []chainhash.Hash) error {
+	return walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		rootBucket := tx.ReadWriteBucket([]byte("header-index"))
+		// bbolt keeps the key and value slices until the transaction is
+		// committed so each entry gets its own.
+		for height := range hashes[:len(hashes)-1] {
+			heightBytes := make([]byte, 4)
+			binary.BigEndian.PutUint32(heightBytes, uint32(height))
+			if err := rootBucket.Put(hashes[height][:], heightBytes); err != nil {
+				return err
+			}
+		}
+		tip := len(hashes) - 1
+		return putDBTip(rootBucket, uint32(tip), hashes[tip])
+	})
+}
@@ -0,0 +1,130 @@
+package chainservice
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightninglabs/neutrino/headerfs"
+)
+
+// regtestHeaders returns n regtest headers following the genesis block,
+// mined unless unmined is the height of a header whose hash misses the target.
+func regtestHeaders(n int, unmined int) []wire.BlockHeader {
+	headers := []wire.BlockHeader{chaincfg.RegressionNetParams.GenesisBlock.Header}
+	for height := 1; height <= n; height++ {
+		prev := headers[height-1]
+		headers = append(headers, nextHeader(prev, prev.Bits, 10*time.Minute, height != unmined))
+	}
+	return headers
+}
+
+// nextHeader returns the header following prev with bits, whose hash meets
+// the target unless mined is false.
+func nextHeader(prev wire.BlockHeader, bits uint32, interval time.Duration, mined bool) wire.BlockHeader {
+	target := blockchain.CompactToBig(bits)
+	header := wire.BlockHeader{
+		Version:   prev.Version,
+		PrevBlock: prev.BlockHash(),
+		Timestamp: prev.Timestamp.Add(interval),
+		Bits:      bits,
+	}
+	for {
+		hash := header.BlockHash()
+		if (blockchain.HashToBig(&hash).Cmp(target) <= 0) == mined {
+			return header
+		}
+		header.Nonce++
+	}
+}
+
+func writeHeaders(t *testing.T, headers []wire.BlockHeader) string {
+	var buf bytes.Buffer
+	for _, header := range headers {
+		if err := header.Serialize(&buf); err != nil {
+			t.Fatalf("header.Serialize: %v", err)
+		}
+	}
+	headersPath := path.Join(t.TempDir(), blockHeadersFile)
+	if err := ioutil.WriteFile(headersPath, buf.Bytes(), 0600); err != nil {
+		t.Fatalf("ioutil.WriteFile: %v", err)
+	}
+	return headersPath
+}
+
+func TestVerifySnapshotHeadersWork(t *testing.T) {
+	params := &chaincfg.RegressionNetParams
+	hashes, err := verifySnapshotHeaders(writeHeaders(t, regtestHeaders(20, -1)), params)
+	if err != nil {
+		t.Fatalf("verifySnapshotHeaders: %v", err)
+	}
+	if len(hashes) != 21 {
+		t.Fatalf("got %v hashes, want 21", len(hashes))
+	}
+
+	_, err = verifySnapshotHeaders(writeHeaders(t, regtestHeaders(20, 12)), params)
+	if err == nil || !strings.Contains(err.Error(), "height 12") {
+		t.Fatalf("expected a proof of work error at height 12, got %v", err)
+	}
+}
+
+func TestVerifySnapshotHeadersMinDifficulty(t *testing.T) {
+	// A retarget every 10 blocks, preceded by a min difficulty block mined
+	// more than 20 minutes after the previous one.
+	params := chaincfg.RegressionNetParams
+	params.TargetTimespan = 10 * params.TargetTimePerBlock
+	headers := regtestHeaders(1, -1)
+	for height := 2; height <= 12; height++ {
+		bits, interval := uint32(0x1f7fffff), 10*time.Minute
+		if height == 9 {
+			bits, interval = params.PowLimitBits, 30*time.Minute
+		}
+		headers = append(headers, nextHeader(headers[height-1], bits, interval, true))
+	}
+
+	if _, err := verifySnapshotHeaders(writeHeaders(t, headers), &params); err != nil {
+		t.Fatalf("verifySnapshotHeaders: %v", err)
+	}
+}
+
+func TestIndexSnapshotHeaders(t *testing.T) {
+	dir := t.TempDir()
+	db, err := walletdb.Create("bdb", path.Join(dir, "neutrino.db"), true, time.Second)
+	if err != nil {
+		t.Fatalf("walletdb.Create: %v", err)
+	}
+	defer db.Close()
+	if _, err := headerfs.NewBlockHeaderStore(dir, db, &chaincfg.RegressionNetParams); err != nil {
+		t.Fatalf("headerfs.NewBlockHeaderStore: %v", err)
+	}
+
+	var hashes []chainhash.Hash
+	for _, header := range regtestHeaders(20, -1) {
+		hashes = append(hashes, header.BlockHash())
+	}
+	if err := indexSnapshotHeaders(db, hashes); err != nil {
+		t.Fatalf("indexSnapshotHeaders: %v", err)
+	}
+	err = walletdb.View(db, func(tx walletdb.ReadTx) error {
+		rootBucket := tx.ReadBucket(headerIndexBucket)
+		for height, hash := range hashes {
+			got := indexHeight(rootBucket, hash[:])
+			if len(got) != 4 || binary.BigEndian.Uint32(got) != uint32(height) {
+				t.Errorf("index height of the header at %v = %x", height, got)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("walletdb.View: %v", err)
+	}
+}
@@ -0,0 +1,120 @@
+package chainservice
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/breez/breez/config"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/peer"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/tor"
+)
+
+// PeerDiagnostics is the result of a handshake with a peer.
+type PeerDiagnostics struct {
+	Addr            string
+	Latency         time.Duration
+	ProtocolVersion uint32
+	Services        wire.ServiceFlag
+	UserAgent       string
+	BestHeight      int32
+}
+
+// SupportsFilters returns true if the peer serves compact filters, which
+// neutrino requires.
+func (d *PeerDiagnostics) SupportsFilters() bool {
+	return d.Services&wire.SFNodeCF == wire.SFNodeCF
+}
+
+/*
+TestPeer connects to the peer and completes the version handshake, returning
+what the peer advertised and how long the handshake took. It fails if the
+handshake isn't done before the context is.
+*/
+func TestPeer(ctx context.Context, addr string, cfg *config.Config) (*PeerDiagnostics, error) {
+	params, err := chainParams(cfg.Network)
+	if err != nil {
+		return nil, err
+	}
+	p, latency, disconnect, err := connectPeer(ctx, addr, params, cfg.NeutrinoCfg, peer.MessageListeners{})
+	if err != nil {
+		return nil, err
+	}
+	defer disconnect()
+	return &PeerDiagnostics{
+		Addr:            p.Addr(),
+		Latency:         latency,
+		ProtocolVersion: p.ProtocolVersion(),
+		Services:        p.Services(),
+		UserAgent:       p.UserAgent(),
+		BestHeight:      p.StartingHeight(),
+	}, nil
+}
+
+/*
+connectPeer connects to the peer outside of neutrino, through the proxy if
+one is configured, and completes the version handshake. It returns the peer, how long the handshake took and the
+function disconnecting it.
+*/
+func connectPeer(ctx context.Context, addr string, params *chaincfg.Params,
+	cfg config.NeutrinoConfig, listeners peer.MessageListeners) (*peer.Peer, time.Duration, func(), error) {
+
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, params.DefaultPort)
+	}
+
+	verAck := make(chan struct{})
+	listeners.OnVerAck = func(*peer.Peer, *wire.MsgVerAck) {
+		close(verAck)
+	}
+	p, err := peer.NewOutboundPeer(&peer.Config{
+		UserAgentName:    "breez",
+		UserAgentVersion: "test",
+		ChainParams:      params,
+		Listeners:        listeners,
+	}, addr)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	start := time.Now()
+	conn, err := dialContext(ctx, addr, cfg)
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("failed to connect to %v: %w", addr, err)
+	}
+	p.AssociateConnection(conn)
+	disconnected := make(chan struct{})
+	go func() {
+		p.WaitForDisconnect()
+		close(disconnected)
+	}()
+	disconnect := func() {
+		p.Disconnect()
+		<-disconnected
+	}
+
+	select {
+	case <-verAck:
+		return p, time.Since(start), disconnect, nil
+	case <-disconnected:
+		return nil, 0, nil, fmt.Errorf("%v disconnected during the handshake", addr)
+	case <-ctx.Done():
+		disconnect()
+		return nil, 0, nil, fmt.Errorf("handshake with %v failed: %w", addr, ctx.Err())
+	}
+}
+
+func dialContext(ctx context.Context, addr string, cfg config.NeutrinoConfig) (net.Conn, error) {
+	if cfg.Proxy == "" {
+		var d net.Dialer
+		return d.DialContext(ctx, "tcp", addr)
+	}
+	timeout := dialTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+	return tor.Dial(addr, cfg.Proxy, cfg.ProxyStreamIsolation, timeout)
+}
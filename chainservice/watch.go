@@ -0,0 +1,112 @@
+package chainservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc/chainrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+const watchRetryInterval = time.Second * 10
+
+// ChainNotifier is implemented by the daemon API which gives access to the
+// chain notifier of the running lnd.
+type ChainNotifier interface {
+	ChainNotifierClient() chainrpc.ChainNotifierClient
+}
+
+/*
+WatchScript registers with the chain notifier for the confirmations of the
+transactions paying to script, starting at heightHint. The details of every
+transaction reaching nConf confirmations are sent to the returned channel,
+which is closed once ctx is done. The registration is renewed when the
+notification stream fails, so it survives daemon restarts.
+*/
+func WatchScript(ctx context.Context, notifier ChainNotifier, script []byte,
+	nConf, heightHint uint32) (<-chan *chainrpc.ConfDetails, error) {
+
+	if len(script) == 0 {
+		return nil, errors.New("a script is required")
+	}
+	if nConf == 0 {
+		nConf = 1
+	}
+	request := &chainrpc.ConfRequest{
+		NumConfs:   nConf,
+		HeightHint: heightHint,
+		Txid:       lntypes.ZeroHash[:],
+		Script:     script,
+	}
+	stream, err := registerConfirmations(ctx, notifier, request)
+	if err != nil {
+		return nil, err
+	}
+
+	confirmed := make(chan *chainrpc.ConfDetails)
+	go func() {
+		defer close(confirmed)
+		for {
+			confEvent, err := stream.Recv()
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if logger != nil {
+					logger.Errorf("WatchScript(%x): failed to receive an event: %v", script, err)
+				}
+				if stream, err = reregisterConfirmations(ctx, notifier, request); err != nil {
+					return
+				}
+				continue
+			}
+			conf := confEvent.GetConf()
+			if conf == nil {
+				continue
+			}
+			select {
+			case confirmed <- conf:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return confirmed, nil
+}
+
+func registerConfirmations(ctx context.Context, notifier ChainNotifier,
+	request *chainrpc.ConfRequest) (chainrpc.ChainNotifier_RegisterConfirmationsNtfnClient, error) {
+
+	client := notifier.ChainNotifierClient()
+	if client == nil {
+		return nil, errors.New("daemon is not ready")
+	}
+	stream, err := client.RegisterConfirmationsNtfn(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("client.RegisterConfirmationsNtfn(%x): %w", request.Script, err)
+	}
+	return stream, nil
+}
+
+// reregisterConfirmations retries the registration until it succeeds or ctx
+// is done.
+func reregisterConfirmations(ctx context.Context, notifier ChainNotifier,
+	request *chainrpc.ConfRequest) (chainrpc.ChainNotifier_RegisterConfirmationsNtfnClient, error) {
+
+	for {
+		select {
+		case <-time.After(watchRetryInterval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		stream, err := registerConfirmations(ctx, notifier, request)
+		if err == nil {
+			return stream, nil
+		}
+		if logger != nil {
+			logger.Errorf("WatchScript(%x): %v", request.Script, err)
+		}
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"os"
 	"path"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/breez/breez/chainservice"
 	"github.com/breez/breez/config"
@@ -12,19 +14,49 @@ import (
 	"github.com/breez/breez/refcount"
 	"github.com/btcsuite/btclog"
 	"github.com/lightningnetwork/lnd/channeldb"
+	bbolt "go.etcd.io/bbolt"
 )
 
 const (
 	directoryPattern = "data/graph/{{network}}/"
 	dbName           = "channel.db"
+
+	// defaultCompactionMaxSize is the size above which channel.db is always
+	// compacted.
+	defaultCompactionMaxSize = 200000000
+
+	// defaultCompactionMinSize and defaultCompactionFreeRatio define when
+	// a smaller channel.db is compacted because most of it is free pages.
+	defaultCompactionMinSize   = 50000000
+	defaultCompactionFreeRatio = 0.5
 )
 
 var (
 	serviceRefCounter refcount.ReferenceCountable
 	chanDB            *channeldb.DB
 	logger            btclog.Logger
+
+	progressMu         sync.Mutex
+	compactionProgress func(percent int)
 )
 
+// SetCompactionProgress sets the function called with the progress
+// percentage while channel.db is compacted on open.
+func SetCompactionProgress(progress func(percent int)) {
+	progressMu.Lock()
+	defer progressMu.Unlock()
+	compactionProgress = progress
+}
+
+func reportCompactionProgress(percent int) {
+	progressMu.Lock()
+	progress := compactionProgress
+	progressMu.Unlock()
+	if progress != nil {
+		progress(percent)
+	}
+}
+
 // Get returns a Ch
 func Get(workingDir string) (db *channeldb.DB, cleanupFn func() error, err error) {
 	service, release, err := serviceRefCounter.Get(
@@ -50,7 +82,45 @@ func release() error {
 	return chanDB.Close()
 }
 
-func compactDB(graphDir string) error {
+// shouldCompact returns true if channel.db is bigger than the max size, or
+// bigger than the min size with a free pages ratio above the threshold.
+func shouldCompact(dbPath string, size int64, cfg config.ChannelDBConfig) (bool, error) {
+	maxSize := cfg.CompactionMaxSize
+	if maxSize <= 0 {
+		maxSize = defaultCompactionMaxSize
+	}
+	if size > maxSize {
+		return true, nil
+	}
+	minSize := cfg.CompactionMinSize
+	if minSize <= 0 {
+		minSize = defaultCompactionMinSize
+	}
+	if size < minSize {
+		return false, nil
+	}
+	freeRatio := cfg.CompactionFreeRatio
+	if freeRatio <= 0 {
+		freeRatio = defaultCompactionFreeRatio
+	}
+
+	// The freelist is only loaded when the db is opened read-write, it is
+	// safe as channel.db isn't opened yet.
+	db, err := bbolt.Open(dbPath, 0600, &bbolt.Options{Timeout: time.Second})
+	if err != nil {
+		return false, err
+	}
+	defer db.Close()
+	stats := db.Stats()
+	free := int64(stats.FreePageN+stats.PendingPageN) * int64(db.Info().PageSize)
+	logger.Infof("channel.db size: %v, free pages size: %v", size, free)
+	return float64(free)/float64(size) >= freeRatio, nil
+}
+
+func compactDB(graphDir string, cfg config.ChannelDBConfig) error {
+	if cfg.DisableCompaction {
+		return nil
+	}
 	dbPath := path.Join(graphDir, dbName)
 	f, err := os.Stat(dbPath)
 	if err != nil {
@@ -59,15 +129,17 @@ func compactDB(graphDir string) error {
 		}
 		return err
 	}
-	if f.Size() <= 200000000 {
-		return nil
+	compact, err := shouldCompact(dbPath, f.Size(), cfg)
+	if err != nil || !compact {
+		return err
 	}
 	newFile, err := ioutil.TempFile(graphDir, "cdb-compact")
 	if err != nil {
 		return err
 	}
-	if err = chainservice.BoltCopy(dbPath, newFile.Name(),
-		func(keyPath [][]byte, k []byte, v []byte) bool { return false }); err != nil {
+	if err = chainservice.BoltCopyWithProgress(dbPath, newFile.Name(),
+		func(keyPath [][]byte, k []byte, v []byte) bool { return false },
+		reportCompactionProgress); err != nil {
 		return err
 	}
 	if err = os.Rename(dbPath, dbPath+".old"); err != nil {
@@ -77,7 +149,7 @@ func compactDB(graphDir string) error {
 		logger.Criticalf("Error when renaming the new channeldb file: %v", err)
 		return err
 	}
-	logger.Infof("channel.db was compacted because it's too big")
+	logger.Infof("channel.db of size %v was compacted", f.Size())
 	return nil
 }
 
@@ -109,7 +181,7 @@ func createService(workingDir string) (*channeldb.DB, error) {
 	}
 
 	graphDir := path.Join(workingDir, strings.Replace(directoryPattern, "{{network}}", config.Network, -1))
-	if err = compactDB(graphDir); err != nil {
+	if err = compactDB(graphDir, config.ChannelDBCfg); err != nil {
 		logger.Errorf("Error in compactDB: %v", err)
 	}
 
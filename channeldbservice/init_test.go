@@ -0,0 +1,85 @@
+package channeldbservice
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/breez/breez/config"
+	"github.com/btcsuite/btclog"
+	bbolt "go.etcd.io/bbolt"
+)
+
+func TestShouldCompactFreePages(t *testing.T) {
+	if logger == nil {
+		logger = btclog.Disabled
+	}
+	dir, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	dbPath := path.Join(dir, dbName)
+
+	db, err := bbolt.Open(dbPath, 0600, nil)
+	if err != nil {
+		t.Fatalf("bbolt.Open: %v", err)
+	}
+	bucket := []byte("bucket")
+	value := make([]byte, 1000)
+	for i := 0; i < 10; i++ {
+		err = db.Update(func(tx *bbolt.Tx) error {
+			b, err := tx.CreateBucketIfNotExists(bucket)
+			if err != nil {
+				return err
+			}
+			for j := 0; j < 1000; j++ {
+				if err := b.Put([]byte{byte(i), byte(j >> 8), byte(j)}, value); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("db.Update: %v", err)
+		}
+	}
+	cfg := config.ChannelDBConfig{CompactionMinSize: 1, CompactionFreeRatio: 0.5}
+	size := func() int64 {
+		info, err := os.Stat(dbPath)
+		if err != nil {
+			t.Fatalf("os.Stat: %v", err)
+		}
+		return info.Size()
+	}
+
+	// Most of the pages are used.
+	db.Close()
+	compact, err := shouldCompact(dbPath, size(), cfg)
+	if err != nil {
+		t.Fatalf("shouldCompact: %v", err)
+	}
+	if compact {
+		t.Fatalf("shouldCompact of a full db = true")
+	}
+
+	db, err = bbolt.Open(dbPath, 0600, nil)
+	if err != nil {
+		t.Fatalf("bbolt.Open: %v", err)
+	}
+	err = db.Update(func(tx *bbolt.Tx) error {
+		return tx.DeleteBucket(bucket)
+	})
+	if err != nil {
+		t.Fatalf("db.Update: %v", err)
+	}
+	db.Close()
+	compact, err = shouldCompact(dbPath, size(), cfg)
+	if err != nil {
+		t.Fatalf("shouldCompact: %v", err)
+	}
+	if !compact {
+		t.Fatalf("shouldCompact of a mostly free db = false")
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"path"
 	"sync"
+	"time"
 
 	flags "github.com/jessevdk/go-flags"
 )
@@ -25,6 +26,153 @@ type JobConfig struct {
 	AssertFilterHeader string   `long:"assertfilterheader"`
 }
 
+/*
+LNURLConfig holds the configuration of the http client used by the lnurl flows
+*/
+type LNURLConfig struct {
+	Timeout         time.Duration `long:"lnurltimeout"`
+	Retries         int           `long:"lnurlretries"`
+	MaxResponseSize int64         `long:"lnurlmaxresponsesize"`
+	Proxy           string        `long:"lnurlproxy"`
+	PayFromNodes    bool          `long:"lnurlpayfromnodes"`
+	CacheTTL        time.Duration `long:"lnurlcachettl"`
+}
+
+/*
+SweepConfig holds the configuration used when sweeping the wallet coins
+*/
+type SweepConfig struct {
+	DustLimit  int64 `long:"sweepdustlimit"`
+	DisableRBF bool  `long:"sweepdisablerbf"`
+}
+
+/*
+ChangeConfig holds the policy of the change addresses used by the crafted
+transactions
+*/
+type ChangeConfig struct {
+	AddressType string `long:"changeaddresstype"`
+	ReuseUnused bool   `long:"changereuseunused"`
+}
+
+/*
+FeeConfig holds the configuration of the fee estimators
+*/
+type FeeConfig struct {
+	EstimatesURL      string        `long:"feeestimatesurl"`
+	EstimatesCacheTTL time.Duration `long:"feeestimatescachettl"`
+}
+
+/*
+BroadcastConfig holds the external broadcasters the published transactions
+are pushed to
+*/
+type BroadcastConfig struct {
+	URLs []string `long:"broadcasturl"`
+}
+
+/*
+ListenConfig holds the configuration of the localhost listeners companion
+apps on the same device use to connect to the node
+*/
+type ListenConfig struct {
+	Localhost bool `long:"listenlocalhost"`
+	RPCPort   int  `long:"listenrpcport"`
+	RESTPort  int  `long:"listenrestport"`
+}
+
+/*
+DaemonConfig holds the configuration of the lightning daemon lifecycle
+*/
+type DaemonConfig struct {
+	ShutdownTimeout time.Duration `long:"shutdowntimeout"`
+}
+
+/*
+ChannelDBConfig holds the thresholds of the channel.db compaction on startup
+*/
+type ChannelDBConfig struct {
+	DisableCompaction   bool    `long:"channeldbdisablecompaction"`
+	CompactionMaxSize   int64   `long:"channeldbcompactionmaxsize"`
+	CompactionMinSize   int64   `long:"channeldbcompactionminsize"`
+	CompactionFreeRatio float64 `long:"channeldbcompactionfreeratio"`
+}
+
+/*
+PruneConfig holds the configuration of the lnd database pruning, done when the
+daemon starts, and of the neutrino data pruning. When NeutrinoBeforeBirthday
+is set, the headers of the blocks mined before the wallet birthday are removed
+from the neutrino headers files, except the checkpoints.
+*/
+type PruneConfig struct {
+	InvoicesMaxAge         time.Duration `long:"pruneinvoicesmaxage"`
+	NeutrinoBeforeBirthday bool          `long:"pruneneutrinobeforebirthday"`
+}
+
+/*
+ProtocolConfig holds the lightning protocol features enabled on the node
+*/
+type ProtocolConfig struct {
+	Anchors     bool  `long:"protocolanchors"`
+	Wumbo       bool  `long:"protocolwumbo"`
+	MaxChanSize int64 `long:"protocolmaxchansize"`
+}
+
+/*
+StorageConfig holds the quota of the disk space used by the working directory
+above which the app is warned, in bytes
+*/
+type StorageConfig struct {
+	Quota int64 `long:"storagequota"`
+}
+
+/*
+NeutrinoConfig holds the number of peers the chain service connects to, the
+time after which a peer that doesn't respond or stalls the sync is replaced,
+the download rate in bytes per second while on a metered connection, the
+SOCKS5 proxy, such as tor, the peers are reached through and whether the
+filter headers are verified against other peers
+*/
+type NeutrinoConfig struct {
+	MaxPeers             int           `long:"neutrinomaxpeers"`
+	PeerStallTimeout     time.Duration `long:"neutrinopeerstalltimeout"`
+	MeteredDownloadRate  int64         `long:"neutrinometereddownloadrate"`
+	Proxy                string        `long:"neutrinoproxy"`
+	ProxyStreamIsolation bool          `long:"neutrinoproxystreamisolation"`
+	VerifyFilterHeaders  bool          `long:"neutrinoverifyfilterheaders"`
+	VerifyPeers          []string      `long:"neutrinoverifypeer"`
+	AutoBanDuration      time.Duration `long:"neutrinoautobanduration"`
+}
+
+/*
+SnapshotConfig holds the location of the headers snapshot used to bootstrap
+the chain service and the hex encoded public key that signs it
+*/
+type SnapshotConfig struct {
+	URL    string `long:"snapshoturl"`
+	PubKey string `long:"snapshotpubkey"`
+}
+
+/*
+BitcoindConfig holds the connection to a bitcoind node used as the chain
+backend instead of neutrino. Neutrino is used when RPCHost is empty
+*/
+type BitcoindConfig struct {
+	RPCHost        string `long:"bitcoindrpchost"`
+	RPCUser        string `long:"bitcoindrpcuser"`
+	RPCPass        string `long:"bitcoindrpcpass"`
+	ZMQPubRawBlock string `long:"bitcoindzmqpubrawblock"`
+	ZMQPubRawTx    string `long:"bitcoindzmqpubrawtx"`
+}
+
+/*
+LndConfig holds the lnd options that override the ones breez sets, given as
+lndoverride=option:value
+*/
+type LndConfig struct {
+	Overrides map[string]string `long:"lndoverride"`
+}
+
 /*
 Config holds the breez configuration
 */
@@ -41,9 +189,55 @@ type Config struct {
 	BugReportURL       string `long:"bugreporturl"`
 	BugReportURLSecret string `long:"bugreporturlsecret"`
 	TxSpentURL         string `long:"txspenturl"`
+	AcceptKeySend      bool   `long:"acceptkeysend"`
 
 	//Job Options
 	JobCfg JobConfig `group:"Job Options"`
+
+	//LNURL Options
+	LNURLCfg LNURLConfig `group:"LNURL Options"`
+
+	//Sweep Options
+	SweepCfg SweepConfig `group:"Sweep Options"`
+
+	//Change Options
+	ChangeCfg ChangeConfig `group:"Change Options"`
+
+	//Fee Options
+	FeeCfg FeeConfig `group:"Fee Options"`
+
+	//Broadcast Options
+	BroadcastCfg BroadcastConfig `group:"Broadcast Options"`
+
+	//Listen Options
+	ListenCfg ListenConfig `group:"Listen Options"`
+
+	//Daemon Options
+	DaemonCfg DaemonConfig `group:"Daemon Options"`
+
+	//ChannelDB Options
+	ChannelDBCfg ChannelDBConfig `group:"ChannelDB Options"`
+
+	//Prune Options
+	PruneCfg PruneConfig `group:"Prune Options"`
+
+	//Lnd Options
+	LndCfg LndConfig `group:"Lnd Options"`
+
+	//Protocol Options
+	ProtocolCfg ProtocolConfig `group:"Protocol Options"`
+
+	//Storage Options
+	StorageCfg StorageConfig `group:"Storage Options"`
+
+	//Neutrino Options
+	NeutrinoCfg NeutrinoConfig `group:"Neutrino Options"`
+
+	//Snapshot Options
+	SnapshotCfg SnapshotConfig `group:"Snapshot Options"`
+
+	//Bitcoind Options
+	BitcoindCfg BitcoindConfig `group:"Bitcoind Options"`
 }
 
 // GetConfig returns the config object
@@ -0,0 +1,32 @@
+package db
+
+import (
+	"github.com/breez/breez/data"
+	"github.com/golang/protobuf/proto"
+	bolt "go.etcd.io/bbolt"
+)
+
+// SaveOnchainAddress stores an address handed out to the user.
+func (db *DB) SaveOnchainAddress(address *data.OnchainAddress) error {
+	b, err := proto.Marshal(address)
+	if err != nil {
+		return err
+	}
+	return db.saveItem([]byte(onchainAddressesBucket), []byte(address.Address), b)
+}
+
+// FetchOnchainAddresses fetches all the addresses handed out to the user.
+func (db *DB) FetchOnchainAddresses() ([]*data.OnchainAddress, error) {
+	var addresses []*data.OnchainAddress
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(onchainAddressesBucket)).ForEach(func(k, v []byte) error {
+			var address data.OnchainAddress
+			if err := proto.Unmarshal(v, &address); err != nil {
+				return err
+			}
+			addresses = append(addresses, &address)
+			return nil
+		})
+	})
+	return addresses, err
+}
@@ -0,0 +1,56 @@
+package db
+
+import (
+	"encoding/json"
+
+	"github.com/breez/breez/data"
+	"github.com/golang/protobuf/proto"
+	bolt "go.etcd.io/bbolt"
+)
+
+// SaveClosedChannel stores the closed channel keyed by its channel point.
+func (db *DB) SaveClosedChannel(channel *data.ClosedChannel) error {
+	buf, err := proto.Marshal(channel)
+	if err != nil {
+		return err
+	}
+	return db.saveItem([]byte(closedChannelsInfoBucket), []byte(channel.ChannelPoint), buf)
+}
+
+// FetchClosedChannel fetches the closed channel of channelPoint, or nil if it
+// wasn't recorded.
+func (db *DB) FetchClosedChannel(channelPoint string) (*data.ClosedChannel, error) {
+	buf, err := db.fetchItem([]byte(closedChannelsInfoBucket), []byte(channelPoint))
+	if err != nil || buf == nil {
+		return nil, err
+	}
+	return unmarshalClosedChannel(buf)
+}
+
+// FetchClosedChannels fetches all the recorded closed channels.
+func (db *DB) FetchClosedChannels() ([]*data.ClosedChannel, error) {
+	var channels []*data.ClosedChannel
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(closedChannelsInfoBucket)).ForEach(func(k, v []byte) error {
+			c, err := unmarshalClosedChannel(v)
+			if err != nil {
+				return err
+			}
+			channels = append(channels, c)
+			return nil
+		})
+	})
+	return channels, err
+}
+
+// unmarshalClosedChannel decodes a closed channel, falling back to the JSON
+// encoding of the channels recorded by older versions.
+func unmarshalClosedChannel(buf []byte) (*data.ClosedChannel, error) {
+	var channel data.ClosedChannel
+	if err := proto.Unmarshal(buf, &channel); err != nil {
+		if jsonErr := json.Unmarshal(buf, &channel); jsonErr != nil {
+			return nil, err
+		}
+	}
+	return &channel, nil
+}
@@ -51,6 +51,39 @@ const (
 
 	//lnurl-pay
 	lnurlPayBucket = "lnurl-pay-bucket"
+
+	//lnurl auth identities
+	lnurlAuthIdentitiesBucket = "lnurl-auth-identities-bucket"
+
+	//lnurl-pay links
+	lnurlPayLinksBucket = "lnurl-pay-links-bucket"
+
+	//pending lnurl-withdraw callbacks
+	lnurlWithdrawQueueBucket = "lnurl-withdraw-queue-bucket"
+
+	//onchain transaction labels
+	txLabelsBucket = "tx-labels-bucket"
+
+	//onchain sweeps
+	sweepBucket = "sweep-bucket"
+
+	//handed out onchain addresses
+	onchainAddressesBucket = "onchain-addresses-bucket"
+
+	//watched onchain addresses
+	watchedAddressesBucket = "watched-addresses-bucket"
+
+	//payment settings
+	paymentSettingsBucket = "payment-settings-bucket"
+
+	//hold invoices
+	holdInvoicesBucket = "hold-invoices-bucket"
+
+	//closed channels closure info
+	closedChannelsInfoBucket = "closed-channels-info-bucket"
+
+	//peer bans
+	peerBansBucket = "peer-bans-bucket"
 )
 
 var (
@@ -183,6 +216,61 @@ func openDB(dbPath string, log btclog.Logger) (*DB, error) {
 			return err
 		}
 
+		_, err = tx.CreateBucketIfNotExists([]byte(lnurlAuthIdentitiesBucket))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists([]byte(lnurlPayLinksBucket))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists([]byte(lnurlWithdrawQueueBucket))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists([]byte(txLabelsBucket))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists([]byte(sweepBucket))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists([]byte(onchainAddressesBucket))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists([]byte(watchedAddressesBucket))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists([]byte(paymentSettingsBucket))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists([]byte(holdInvoicesBucket))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists([]byte(closedChannelsInfoBucket))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists([]byte(peerBansBucket))
+		if err != nil {
+			return err
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -0,0 +1,27 @@
+package db
+
+import (
+	bolt "go.etcd.io/bbolt"
+)
+
+// AddHoldInvoiceHash adds the hash of a hold invoice to track.
+func (db *DB) AddHoldInvoiceHash(hash []byte, payreq []byte) error {
+	return db.saveItem([]byte(holdInvoicesBucket), hash, payreq)
+}
+
+// RemoveHoldInvoiceHash removes a hold invoice hash from tracking.
+func (db *DB) RemoveHoldInvoiceHash(hash []byte) error {
+	return db.deleteItem([]byte(holdInvoicesBucket), hash)
+}
+
+// FetchHoldInvoiceHashes fetches all the hold invoice hashes to track.
+func (db *DB) FetchHoldInvoiceHashes() ([][]byte, error) {
+	var hashes [][]byte
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(holdInvoicesBucket)).ForEach(func(k, v []byte) error {
+			hashes = append(hashes, append([]byte(nil), k...))
+			return nil
+		})
+	})
+	return hashes, err
+}
@@ -1,6 +1,11 @@
 package db
 
-import bolt "go.etcd.io/bbolt"
+import (
+	"encoding/json"
+
+	"github.com/breez/breez/data"
+	bolt "go.etcd.io/bbolt"
+)
 
 // FetchLNURLAuthKey fetches the bip32 master key for lnurl auth.
 func (db *DB) FetchLNURLAuthKey(createNew func() ([]byte, error)) ([]byte, error) {
@@ -22,3 +27,83 @@ func (db *DB) FetchLNURLAuthKey(createNew func() ([]byte, error)) ([]byte, error
 	})
 	return key, err
 }
+
+// FetchLNURLAuthScheme fetches the key derivation scheme used for lnurl auth.
+// If no scheme was set yet, defaultScheme is invoked with a flag telling
+// whether a random master key already exists and its result is stored.
+func (db *DB) FetchLNURLAuthScheme(defaultScheme func(hasKey bool) string) (string, error) {
+	var scheme []byte
+	err := db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(lnurlAuthBucket))
+		scheme = b.Get([]byte("scheme"))
+		if scheme == nil {
+			scheme = []byte(defaultScheme(b.Get([]byte("key")) != nil))
+			return b.Put([]byte("scheme"), scheme)
+		}
+		return nil
+	})
+	return string(scheme), err
+}
+
+// SetLNURLAuthScheme sets the key derivation scheme used for lnurl auth.
+func (db *DB) SetLNURLAuthScheme(scheme string) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(lnurlAuthBucket)).Put([]byte("scheme"), []byte(scheme))
+	})
+}
+
+// SaveLNURLAuthIdentity stores the identity used to authenticate to its host.
+// The first login time of an existing identity is preserved.
+func (db *DB) SaveLNURLAuthIdentity(identity *data.LNURLAuthIdentity) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(lnurlAuthIdentitiesBucket))
+		if v := b.Get([]byte(identity.Host)); v != nil {
+			existing, err := deserializeLNURLAuthIdentity(v)
+			if err != nil {
+				return err
+			}
+			identity.FirstLogin = existing.FirstLogin
+		}
+		buf, err := json.Marshal(identity)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(identity.Host), buf)
+	})
+}
+
+// FetchLNURLAuthIdentity fetches the identity used to authenticate to host.
+func (db *DB) FetchLNURLAuthIdentity(host string) (*data.LNURLAuthIdentity, error) {
+	v, err := db.fetchItem([]byte(lnurlAuthIdentitiesBucket), []byte(host))
+	if err != nil || v == nil {
+		return nil, err
+	}
+	return deserializeLNURLAuthIdentity(v)
+}
+
+// FetchLNURLAuthIdentities fetches all the identities the wallet authenticated with.
+func (db *DB) FetchLNURLAuthIdentities() ([]*data.LNURLAuthIdentity, error) {
+	var identities []*data.LNURLAuthIdentity
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(lnurlAuthIdentitiesBucket)).ForEach(func(k, v []byte) error {
+			identity, err := deserializeLNURLAuthIdentity(v)
+			if err != nil {
+				return err
+			}
+			identities = append(identities, identity)
+			return nil
+		})
+	})
+	return identities, err
+}
+
+// DeleteLNURLAuthIdentity deletes the identity used to authenticate to host.
+func (db *DB) DeleteLNURLAuthIdentity(host string) error {
+	return db.deleteItem([]byte(lnurlAuthIdentitiesBucket), []byte(host))
+}
+
+func deserializeLNURLAuthIdentity(bytes []byte) (*data.LNURLAuthIdentity, error) {
+	var identity data.LNURLAuthIdentity
+	err := json.Unmarshal(bytes, &identity)
+	return &identity, err
+}
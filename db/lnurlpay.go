@@ -34,6 +34,21 @@ func (db *DB) SaveLNUrlPayInfo(info *data.LNUrlPayInfo) error {
 	return err
 }
 
+// UpdateLNUrlPayInfo overwrites a previously saved LNUrlPayInfo.
+func (db *DB) UpdateLNUrlPayInfo(info *data.LNUrlPayInfo) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(lnurlPayBucket))
+		if b.Get([]byte(info.PaymentHash)) == nil {
+			return fmt.Errorf("lnurl-pay info for paymentHash %v not found", info.PaymentHash)
+		}
+		buf, err := json.Marshal(info)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(info.PaymentHash), buf)
+	})
+}
+
 func (db *DB) FetchLNUrlPayInfo(paymentHash string) (*data.LNUrlPayInfo, error) {
 
 	var info *data.LNUrlPayInfo
@@ -81,3 +96,33 @@ func deserializeLNUrlPayInfo(bytes []byte) (*data.LNUrlPayInfo, error) {
 	err := json.Unmarshal(bytes, &info)
 	return &info, err
 }
+
+// SaveLNUrlPayLink stores a reusable lnurl-pay link, keyed by its callback.
+func (db *DB) SaveLNUrlPayLink(link *data.LNURLPayResponse1) error {
+	buf, err := json.Marshal(link)
+	if err != nil {
+		return err
+	}
+	return db.saveItem([]byte(lnurlPayLinksBucket), []byte(link.Callback), buf)
+}
+
+// FetchLNUrlPayLinks fetches all the stored lnurl-pay links.
+func (db *DB) FetchLNUrlPayLinks() ([]*data.LNURLPayResponse1, error) {
+	var links []*data.LNURLPayResponse1
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(lnurlPayLinksBucket)).ForEach(func(k, v []byte) error {
+			var link data.LNURLPayResponse1
+			if err := json.Unmarshal(v, &link); err != nil {
+				return err
+			}
+			links = append(links, &link)
+			return nil
+		})
+	})
+	return links, err
+}
+
+// DeleteLNUrlPayLink deletes the lnurl-pay link with the given callback.
+func (db *DB) DeleteLNUrlPayLink(callback string) error {
+	return db.deleteItem([]byte(lnurlPayLinksBucket), []byte(callback))
+}
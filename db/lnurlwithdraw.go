@@ -0,0 +1,39 @@
+package db
+
+import (
+	"encoding/json"
+
+	"github.com/breez/breez/data"
+	bolt "go.etcd.io/bbolt"
+)
+
+// SavePendingLNURLWithdraw stores a withdraw callback that should be retried,
+// keyed by its invoice.
+func (db *DB) SavePendingLNURLWithdraw(pending *data.PendingLNURLWithdraw) error {
+	buf, err := json.Marshal(pending)
+	if err != nil {
+		return err
+	}
+	return db.saveItem([]byte(lnurlWithdrawQueueBucket), []byte(pending.Bolt11), buf)
+}
+
+// FetchPendingLNURLWithdraws fetches all the withdraw callbacks that should be retried.
+func (db *DB) FetchPendingLNURLWithdraws() ([]*data.PendingLNURLWithdraw, error) {
+	var pending []*data.PendingLNURLWithdraw
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(lnurlWithdrawQueueBucket)).ForEach(func(k, v []byte) error {
+			var p data.PendingLNURLWithdraw
+			if err := json.Unmarshal(v, &p); err != nil {
+				return err
+			}
+			pending = append(pending, &p)
+			return nil
+		})
+	})
+	return pending, err
+}
+
+// DeletePendingLNURLWithdraw deletes the pending withdraw callback of bolt11.
+func (db *DB) DeletePendingLNURLWithdraw(bolt11 string) error {
+	return db.deleteItem([]byte(lnurlWithdrawQueueBucket), []byte(bolt11))
+}
@@ -0,0 +1,31 @@
+package db
+
+import (
+	"github.com/breez/breez/data"
+	"github.com/golang/protobuf/proto"
+)
+
+const mppSettingsKey = "mpp-settings"
+
+// SetMPPSettings stores the multi-path payment settings.
+func (db *DB) SetMPPSettings(settings *data.MPPSettings) error {
+	b, err := proto.Marshal(settings)
+	if err != nil {
+		return err
+	}
+	return db.saveItem([]byte(paymentSettingsBucket), []byte(mppSettingsKey), b)
+}
+
+// GetMPPSettings fetches the multi-path payment settings, or nil if they were
+// never set.
+func (db *DB) GetMPPSettings() (*data.MPPSettings, error) {
+	b, err := db.fetchItem([]byte(paymentSettingsBucket), []byte(mppSettingsKey))
+	if err != nil || len(b) == 0 {
+		return nil, err
+	}
+	var settings data.MPPSettings
+	if err := proto.Unmarshal(b, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
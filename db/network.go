@@ -6,8 +6,9 @@ import (
 )
 
 const (
-	peersKey      = "peers"
-	txSpentURLKey = "txspenturl"
+	peersKey                  = "peers"
+	txSpentURLKey             = "txspenturl"
+	filterHeaderCheckpointKey = "filterheadercheckpoint"
 )
 
 func (db *DB) SetPeers(peers []string) error {
@@ -69,3 +70,16 @@ func (db *DB) GetTxSpentURL(defaultURL string) (txSpentURL string, isDefault boo
 	txSpentURL = string(b)
 	return
 }
+
+func (db *DB) SetFilterHeaderCheckpoint(checkpoint string) error {
+	err := db.saveItem([]byte(networkBucket), []byte(filterHeaderCheckpointKey), []byte(checkpoint))
+	return err
+}
+
+func (db *DB) GetFilterHeaderCheckpoint() (string, error) {
+	b, err := db.fetchItem([]byte(networkBucket), []byte(filterHeaderCheckpointKey))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -56,6 +56,7 @@ type PaymentInfo struct {
 	IsKeySend                  bool
 	GroupKey                   string
 	GroupName                  string
+	CustomRecords              map[uint64][]byte
 
 	//For closed channels
 	ClosedChannelPoint      string
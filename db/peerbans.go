@@ -0,0 +1,37 @@
+package db
+
+import (
+	"github.com/breez/breez/data"
+	"github.com/golang/protobuf/proto"
+	bolt "go.etcd.io/bbolt"
+)
+
+// SavePeerBan stores the ban of a chain peer keyed by its host.
+func (db *DB) SavePeerBan(ban *data.PeerBan) error {
+	buf, err := proto.Marshal(ban)
+	if err != nil {
+		return err
+	}
+	return db.saveItem([]byte(peerBansBucket), []byte(ban.Host), buf)
+}
+
+// DeletePeerBan deletes the ban of the chain peer host.
+func (db *DB) DeletePeerBan(host string) error {
+	return db.deleteItem([]byte(peerBansBucket), []byte(host))
+}
+
+// FetchPeerBans fetches all the stored chain peers bans.
+func (db *DB) FetchPeerBans() ([]*data.PeerBan, error) {
+	var bans []*data.PeerBan
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(peerBansBucket)).ForEach(func(k, v []byte) error {
+			var b data.PeerBan
+			if err := proto.Unmarshal(v, &b); err != nil {
+				return err
+			}
+			bans = append(bans, &b)
+			return nil
+		})
+	})
+	return bans, err
+}
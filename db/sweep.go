@@ -0,0 +1,147 @@
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/breez/breez/data"
+	"github.com/golang/protobuf/proto"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	autoSweepPolicyKey            = "auto-sweep-policy"
+	timelockedFundsClaimKeyPrefix = "timelocked-funds-claim-"
+	sweepPackageKeyPrefix         = "sweep-package-"
+)
+
+// SetAutoSweepPolicy stores the auto sweep policy. A nil policy deletes it.
+func (db *DB) SetAutoSweepPolicy(policy *data.AutoSweepPolicy) error {
+	if policy == nil {
+		return db.deleteItem([]byte(sweepBucket), []byte(autoSweepPolicyKey))
+	}
+	b, err := proto.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	return db.saveItem([]byte(sweepBucket), []byte(autoSweepPolicyKey), b)
+}
+
+// GetAutoSweepPolicy fetches the auto sweep policy, or nil if there is none.
+func (db *DB) GetAutoSweepPolicy() (*data.AutoSweepPolicy, error) {
+	b, err := db.fetchItem([]byte(sweepBucket), []byte(autoSweepPolicyKey))
+	if err != nil || len(b) == 0 {
+		return nil, err
+	}
+	var policy data.AutoSweepPolicy
+	if err := proto.Unmarshal(b, &policy); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// AddTimelockedFundsClaim stores the claim of timelocked funds that should be
+// forwarded to the claim address. The claim is stored per outpoint so it
+// replaces only the previous claims of the same outpoints.
+func (db *DB) AddTimelockedFundsClaim(claim *data.TimelockedFundsClaim) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(sweepBucket))
+		for _, o := range claim.Outpoints {
+			b, err := proto.Marshal(&data.TimelockedFundsClaim{
+				Outpoints:   []*data.OutPoint{o},
+				Address:     claim.Address,
+				SatPerVbyte: claim.SatPerVbyte,
+			})
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put(timelockedFundsClaimKey(o), b); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// FetchTimelockedFundsClaims fetches the pending claims of timelocked funds,
+// one per outpoint.
+func (db *DB) FetchTimelockedFundsClaims() ([]*data.TimelockedFundsClaim, error) {
+	var claims []*data.TimelockedFundsClaim
+	err := db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(sweepBucket)).Cursor()
+		prefix := []byte(timelockedFundsClaimKeyPrefix)
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			var claim data.TimelockedFundsClaim
+			if err := proto.Unmarshal(v, &claim); err != nil {
+				return err
+			}
+			claims = append(claims, &claim)
+		}
+		return nil
+	})
+	return claims, err
+}
+
+// DeleteTimelockedFundsClaims deletes the claims of outpoints.
+func (db *DB) DeleteTimelockedFundsClaims(outpoints []*data.OutPoint) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(sweepBucket))
+		for _, o := range outpoints {
+			if err := bucket.Delete(timelockedFundsClaimKey(o)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func timelockedFundsClaimKey(o *data.OutPoint) []byte {
+	return []byte(fmt.Sprintf("%v%v:%v", timelockedFundsClaimKeyPrefix, o.Txid, o.OutputIndex))
+}
+
+// SaveSweepPackage stores the package of the crafted sweep transaction txid.
+// Packages created before prunedBefore are deleted.
+func (db *DB) SaveSweepPackage(txid string, pkg *data.SweepPackage, prunedBefore int64) error {
+	b, err := proto.Marshal(pkg)
+	if err != nil {
+		return err
+	}
+	return db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(sweepBucket))
+		var pruned [][]byte
+		err := bucket.ForEach(func(k, v []byte) error {
+			if !strings.HasPrefix(string(k), sweepPackageKeyPrefix) {
+				return nil
+			}
+			var p data.SweepPackage
+			if err := proto.Unmarshal(v, &p); err != nil || p.Created < prunedBefore {
+				pruned = append(pruned, append([]byte{}, k...))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range pruned {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		return bucket.Put([]byte(sweepPackageKeyPrefix+txid), b)
+	})
+}
+
+// FetchSweepPackage fetches the package of the sweep transaction txid, or nil
+// if there is none.
+func (db *DB) FetchSweepPackage(txid string) (*data.SweepPackage, error) {
+	b, err := db.fetchItem([]byte(sweepBucket), []byte(sweepPackageKeyPrefix+txid))
+	if err != nil || len(b) == 0 {
+		return nil, err
+	}
+	var pkg data.SweepPackage
+	if err := proto.Unmarshal(b, &pkg); err != nil {
+		return nil, err
+	}
+	return &pkg, nil
+}
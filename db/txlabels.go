@@ -0,0 +1,22 @@
+package db
+
+import (
+	bolt "go.etcd.io/bbolt"
+)
+
+// SaveTxLabel stores the label the user gave to the transaction txid.
+func (db *DB) SaveTxLabel(txid, label string) error {
+	return db.saveItem([]byte(txLabelsBucket), []byte(txid), []byte(label))
+}
+
+// FetchTxLabels fetches the labels of all the labeled transactions keyed by txid.
+func (db *DB) FetchTxLabels() (map[string]string, error) {
+	labels := make(map[string]string)
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(txLabelsBucket)).ForEach(func(k, v []byte) error {
+			labels[string(k)] = string(v)
+			return nil
+		})
+	})
+	return labels, err
+}
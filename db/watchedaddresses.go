@@ -0,0 +1,37 @@
+package db
+
+import (
+	"github.com/breez/breez/data"
+	"github.com/golang/protobuf/proto"
+	bolt "go.etcd.io/bbolt"
+)
+
+// SaveWatchedAddress stores an address watched for confirmations under key.
+func (db *DB) SaveWatchedAddress(key string, watched *data.WatchedAddress) error {
+	b, err := proto.Marshal(watched)
+	if err != nil {
+		return err
+	}
+	return db.saveItem([]byte(watchedAddressesBucket), []byte(key), b)
+}
+
+// FetchWatchedAddresses fetches all the watched addresses keyed by their key.
+func (db *DB) FetchWatchedAddresses() (map[string]*data.WatchedAddress, error) {
+	addresses := make(map[string]*data.WatchedAddress)
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(watchedAddressesBucket)).ForEach(func(k, v []byte) error {
+			var watched data.WatchedAddress
+			if err := proto.Unmarshal(v, &watched); err != nil {
+				return err
+			}
+			addresses[string(k)] = &watched
+			return nil
+		})
+	})
+	return addresses, err
+}
+
+// DeleteWatchedAddress deletes the watched address stored under key.
+func (db *DB) DeleteWatchedAddress(key string) error {
+	return db.deleteItem([]byte(watchedAddressesBucket), []byte(key))
+}
@@ -8,6 +8,7 @@ require (
 	github.com/btcsuite/btcd v0.21.0-beta.0.20201208033208-6bd4c64a54fa
 	github.com/btcsuite/btclog v0.0.0-20170628155309-84c8d2346e9f
 	github.com/btcsuite/btcutil v1.0.2
+	github.com/btcsuite/btcutil/psbt v1.0.3-0.20200826194809-5f93e33af2b0
 	github.com/btcsuite/btcwallet v0.11.1-0.20201207233335-415f37ff11a1
 	github.com/btcsuite/btcwallet/walletdb v1.3.4
 	github.com/btcsuite/btcwallet/wtxmgr v1.2.1-0.20200616004619-ca24ed58cf8a
@@ -24,6 +25,8 @@ require (
 	github.com/remogatto/cloud v0.0.0-20200423094407-c201f07eb401 // indirect
 	github.com/status-im/doubleratchet v0.0.0-20181102064121-4dcb6cba284a
 	github.com/studio-b12/gowebdav v0.0.0-20210427212133-86f8378cf140 // indirect
+	github.com/tidwall/gjson v1.6.0
+	github.com/tv42/zbase32 v0.0.0-20160707012821-501572607d02
 	github.com/tyler-smith/go-bip32 v0.0.0-20170922074101-2c9cfd177564
 	github.com/urfave/cli v1.22.1
 	go.etcd.io/bbolt v1.3.5-0.20200615073812-232d8fc87f50
@@ -0,0 +1,66 @@
+package lnnode
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// ChannelAcceptor decides whether an inbound channel is accepted. Returning
+// an error rejects the channel and the error message is sent to the peer.
+type ChannelAcceptor interface {
+	AcceptChannel(request *lnrpc.ChannelAcceptRequest) error
+}
+
+// ChannelAcceptorFunc is an adapter to use an ordinary function as a
+// ChannelAcceptor.
+type ChannelAcceptorFunc func(request *lnrpc.ChannelAcceptRequest) error
+
+// AcceptChannel calls f(request).
+func (f ChannelAcceptorFunc) AcceptChannel(request *lnrpc.ChannelAcceptRequest) error {
+	return f(request)
+}
+
+// ChannelAcceptPolicy is the breez channel acceptor. The zero value accepts
+// every channel.
+type ChannelAcceptPolicy struct {
+	// PrivateOnly rejects channels that are going to be announced.
+	PrivateOnly bool
+
+	// MinChannelSize rejects channels smaller than this amount of satoshis.
+	MinChannelSize int64
+}
+
+// DefaultChannelAcceptPolicy is the policy used unless another channel
+// acceptor was set.
+var DefaultChannelAcceptPolicy = &ChannelAcceptPolicy{PrivateOnly: true}
+
+// AcceptChannel implements the ChannelAcceptor interface.
+func (p *ChannelAcceptPolicy) AcceptChannel(request *lnrpc.ChannelAcceptRequest) error {
+	private := request.ChannelFlags&uint32(lnwire.FFAnnounceChannel) == 0
+	if p.PrivateOnly && !private {
+		return fmt.Errorf("only private channels are accepted")
+	}
+	if p.MinChannelSize > 0 && request.FundingAmt < uint64(p.MinChannelSize) {
+		return fmt.Errorf("channel size must be at least %v sats", p.MinChannelSize)
+	}
+	return nil
+}
+
+// SetChannelAcceptor sets the acceptor that decides whether inbound channels
+// are accepted. A nil acceptor restores DefaultChannelAcceptPolicy.
+func (d *Daemon) SetChannelAcceptor(acceptor ChannelAcceptor) {
+	d.Lock()
+	defer d.Unlock()
+	d.channelAcceptor = acceptor
+}
+
+func (d *Daemon) getChannelAcceptor() ChannelAcceptor {
+	d.Lock()
+	defer d.Unlock()
+	if d.channelAcceptor == nil {
+		return DefaultChannelAcceptPolicy
+	}
+	return d.channelAcceptor
+}
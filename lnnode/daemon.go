@@ -27,7 +27,10 @@ import (
 )
 
 const (
-	activeGraceDuration = time.Second * 15
+	// defaultRPCPort and defaultRESTPort are the lnd default ports used by
+	// the localhost listeners.
+	defaultRPCPort  = 10009
+	defaultRESTPort = 8080
 )
 
 // Start is used to start the lightning network daemon.
@@ -65,54 +68,6 @@ func (d *Daemon) HasActiveChannel() bool {
 	return len(channels.Channels) > 0
 }
 
-// WaitReadyForPayment is waiting untill we are ready to pay
-func (d *Daemon) WaitReadyForPayment(timeout time.Duration) error {
-	client, err := d.ntfnServer.Subscribe()
-	if err != nil {
-		return err
-	}
-	defer client.Cancel()
-
-	if d.IsReadyForPayment() {
-		return nil
-	}
-
-	d.log.Infof("WaitReadyForPayment - not yet ready for payment, waiting...")
-	timeoutTimer := time.After(timeout)
-	for {
-		select {
-		case event := <-client.Updates():
-			switch event.(type) {
-			case ChannelEvent:
-				d.log.Infof("WaitReadyForPayment got channel event %v", d.IsReadyForPayment())
-				if d.IsReadyForPayment() {
-					return nil
-				}
-			}
-		case <-timeoutTimer:
-			if d.IsReadyForPayment() {
-				return nil
-			}
-			d.log.Info("WaitReadyForPayment got timeout event")
-			return fmt.Errorf("timeout has exceeded while trying to process your request")
-		}
-	}
-}
-
-// IsReadyForPayment returns true if we can pay
-func (d *Daemon) IsReadyForPayment() bool {
-	lnclient := d.APIClient()
-	if lnclient == nil {
-		return false
-	}
-	allChannelsActive, err := d.allChannelsActive(lnclient)
-	if err != nil {
-		d.log.Errorf("Error in allChannelsActive(): %v", err)
-		return false
-	}
-	return allChannelsActive
-}
-
 // NodePubkey returns the identity public key of the lightning node.
 func (d *Daemon) NodePubkey() string {
 	d.Lock()
@@ -123,10 +78,14 @@ func (d *Daemon) NodePubkey() string {
 // Stop is used to stop the lightning network daemon.
 func (d *Daemon) Stop() error {
 	if atomic.SwapInt32(&d.stopped, 1) == 0 {
+		close(d.stopChan)
 		d.stopDaemon()
 		d.ntfnServer.Stop()
 	}
-	d.wg.Wait()
+	if !d.waitForShutdown() {
+		d.log.Errorf("Daemon shutdown timed out")
+		return fmt.Errorf("daemon didn't shut down within %v", d.shutdownTimeout())
+	}
 	d.log.Infof("Daemon shutdown successfully")
 	return nil
 }
@@ -186,13 +145,25 @@ func (d *Daemon) RestartDaemon() error {
 	if atomic.LoadInt32(&d.started) == 0 {
 		return errors.New("Daemon must be started before attempt to restart")
 	}
+	delay, err := d.restartDelay()
+	if err != nil {
+		return err
+	}
+	if delay > 0 {
+		d.log.Infof("waiting %v before restarting the daemon", delay)
+		select {
+		case <-time.After(delay):
+		case <-d.stopChan:
+			return errors.New("daemon was stopped before it was restarted")
+		}
+	}
 	return d.startDaemon()
 }
 
 func (d *Daemon) du(currentPath string, info os.FileInfo) int64 {
 	size := info.Size()
 	if !info.IsDir() {
-		d.log.Errorf("%v: %v", info.Name(), humanize.Bytes(uint64(size)))
+		d.log.Debugf("%v: %v", info.Name(), humanize.Bytes(uint64(size)))
 		return size
 	}
 
@@ -214,7 +185,7 @@ func (d *Daemon) du(currentPath string, info os.FileInfo) int64 {
 		}
 		size += d.du(currentPath+"/"+fi.Name(), fi)
 	}
-	d.log.Errorf("%v: %v", currentPath, humanize.Bytes(uint64(size)))
+	d.log.Debugf("%v: %v", currentPath, humanize.Bytes(uint64(size)))
 	return size
 }
 
@@ -226,7 +197,9 @@ func (d *Daemon) startDaemon() error {
 	}
 
 	d.quitChan = make(chan struct{})
+	quitChan := d.quitChan
 	readyChan := make(chan interface{})
+	cleanup := &subsystemCleanup{}
 
 	d.wg.Add(2)
 	go d.notifyWhenReady(readyChan)
@@ -234,16 +207,29 @@ func (d *Daemon) startDaemon() error {
 
 	// Run the daemon
 	go func() {
+		runStart := time.Now()
+		d.onDaemonStart(runStart)
+		var exitErr error
 		defer func() {
 			defer d.wg.Done()
+			var stopped bool
+			select {
+			case <-quitChan:
+				stopped = true
+			default:
+			}
+			cleanup.run()
+			d.onDaemonExit(exitErr, time.Since(runStart), stopped)
 			go d.stopDaemon()
 		}()
 
 		chanDB, chanDBCleanUp, err := channeldbservice.Get(d.cfg.WorkingDir)
 		if err != nil {
 			d.log.Errorf("failed to create channeldbservice", err)
+			exitErr = err
 			return
 		}
+		cleanup.add(chanDBCleanUp)
 		c, err := chanDB.FetchAllChannels()
 		if err != nil {
 			d.log.Errorf("error when calling chanDB.FetchAllChannels(): %v", err)
@@ -253,17 +239,26 @@ func (d *Daemon) startDaemon() error {
 			}
 		}
 		deleteZombies(chanDB)
-		chainSevice, cleanupFn, err := chainservice.Get(d.cfg.WorkingDir, d.breezDB)
-		if err != nil {
-			chanDBCleanUp()
-			d.log.Errorf("failed to create chainservice", err)
-			return
+		if maxAge := d.cfg.PruneCfg.InvoicesMaxAge; maxAge > 0 {
+			if _, _, err := d.pruneChanDB(chanDB, maxAge); err != nil {
+				d.log.Errorf("failed to prune channel.db: %v", err)
+			}
 		}
 		deps := &Dependencies{
-			workingDir:   d.cfg.WorkingDir,
-			chainService: chainSevice,
-			readyChan:    readyChan,
-			chanDB:       chanDB}
+			workingDir: d.cfg.WorkingDir,
+			readyChan:  readyChan,
+			chanDB:     chanDB}
+		// lnd only uses the chain service when neutrino is the backend.
+		if d.cfg.BitcoindCfg.RPCHost == "" {
+			chainSevice, cleanupFn, err := chainservice.Get(d.cfg.WorkingDir, d.breezDB)
+			if err != nil {
+				d.log.Errorf("failed to create chainservice", err)
+				exitErr = err
+				return
+			}
+			cleanup.add(cleanupFn)
+			deps.chainService = chainSevice
+		}
 		lndConfig, err := d.createConfig(deps.workingDir)
 		if err != nil {
 			d.log.Errorf("failed to create config %v", err)
@@ -272,11 +267,9 @@ func (d *Daemon) startDaemon() error {
 		err = lnd.Main(lndConfig, lnd.ListenerCfg{}, signal.ShutdownChannel(), deps)
 		if err != nil {
 			d.log.Errorf("Breez main function returned with error: %v", err)
+			exitErr = err
 		}
 		d.log.Infof("LND Daemon Finished")
-
-		chanDBCleanUp()
-		cleanupFn()
 	}()
 	return nil
 }
@@ -311,6 +304,44 @@ func (d *Daemon) createConfig(workingDir string) (*lnd.Config, error) {
 	cfg.LogWriter = writer
 	cfg.MinBackoff = time.Second * 20
 	cfg.Bitcoin.SkipChannelConfirmation = true
+	if bitcoind := d.cfg.BitcoindCfg; bitcoind.RPCHost != "" {
+		cfg.Bitcoin.Node = "bitcoind"
+		cfg.BitcoindMode.RPCHost = bitcoind.RPCHost
+		cfg.BitcoindMode.RPCUser = bitcoind.RPCUser
+		cfg.BitcoindMode.RPCPass = bitcoind.RPCPass
+		cfg.BitcoindMode.ZMQPubRawBlock = bitcoind.ZMQPubRawBlock
+		cfg.BitcoindMode.ZMQPubRawTx = bitcoind.ZMQPubRawTx
+	}
+	if d.cfg.AcceptKeySend {
+		cfg.AcceptKeySend = true
+	}
+	if d.cfg.ProtocolCfg.Anchors {
+		cfg.ProtocolOptions.Anchors = true
+	}
+	if d.cfg.ProtocolCfg.Wumbo {
+		// lnd raises the max channel size to the wumbo limit unless set.
+		cfg.ProtocolOptions.WumboChans = true
+	}
+	if d.cfg.ProtocolCfg.MaxChanSize > 0 {
+		cfg.MaxChanSize = d.cfg.ProtocolCfg.MaxChanSize
+	}
+	if d.cfg.ListenCfg.Localhost {
+		rpcPort, restPort := d.cfg.ListenCfg.RPCPort, d.cfg.ListenCfg.RESTPort
+		if rpcPort == 0 {
+			rpcPort = defaultRPCPort
+		}
+		if restPort == 0 {
+			restPort = defaultRESTPort
+		}
+		cfg.RawRPCListeners = []string{fmt.Sprintf("localhost:%v", rpcPort)}
+		cfg.RawRESTListeners = []string{fmt.Sprintf("localhost:%v", restPort)}
+		cfg.DisableRest = false
+		cfg.DisableRestTLS = false
+	}
+	if err := applyConfigOverrides(&cfg, d.cfg.LndCfg.Overrides); err != nil {
+		d.log.Errorf("applyConfigOverrides returned with error: %v", err)
+		return nil, err
+	}
 	conf, err := lnd.ValidateConfig(cfg, "")
 	if err != nil {
 		d.log.Errorf("ValidateConfig returned with error: %v", err)
@@ -325,6 +356,12 @@ func (d *Daemon) stopDaemon() {
 	if !d.daemonRunning {
 		return
 	}
+	select {
+	case <-d.quitChan:
+		d.log.Infof("Daemon.stop() called, still waiting for the daemon to exit")
+		return
+	default:
+	}
 	alive := signal.Alive()
 	d.log.Infof("Daemon.stop() called, stopping breez daemon alive=%v", alive)
 	if alive {
@@ -332,7 +369,11 @@ func (d *Daemon) stopDaemon() {
 	}
 	close(d.quitChan)
 
-	d.wg.Wait()
+	if !d.waitForShutdown() {
+		d.dumpStuckShutdown()
+		go d.onStuckShutdownExit()
+		return
+	}
 	d.daemonRunning = false
 	d.ntfnServer.SendUpdate(DaemonDownEvent{})
 	d.log.Infof("Daemon sent down event")
@@ -349,17 +390,3 @@ func (d *Daemon) notifyWhenReady(readyChan chan interface{}) {
 	case <-d.quitChan:
 	}
 }
-
-func (d *Daemon) allChannelsActive(client lnrpc.LightningClient) (bool, error) {
-	channels, err := client.ListChannels(context.Background(), &lnrpc.ListChannelsRequest{})
-	if err != nil {
-		d.log.Errorf("Error in allChannelsActive() > ListChannels(): %v", err)
-		return false, err
-	}
-	for _, c := range channels.Channels {
-		if !c.Active {
-			return false, nil
-		}
-	}
-	return true, nil
-}
@@ -0,0 +1,72 @@
+package lnnode
+
+import (
+	"context"
+	"time"
+
+	"github.com/breez/breez/chainservice"
+)
+
+const (
+	filterHeadersCheckInterval = 6 * time.Hour
+	filterHeadersCheckTimeout  = time.Minute
+)
+
+// FilterHeaderMismatchEvent is sent when other peers serve filter headers
+// that differ from the ones the chain service stored, which means the peers
+// it is connected to may be withholding or forging filters.
+type FilterHeaderMismatchEvent struct {
+	Mismatches []chainservice.FilterHeaderMismatch
+}
+
+// watchFilterHeaders cross checks the filter headers with other peers when
+// the chain gets synced and periodically after.
+func (d *Daemon) watchFilterHeaders(ctx context.Context) {
+	defer d.wg.Done()
+	if !d.cfg.NeutrinoCfg.VerifyFilterHeaders || d.cfg.BitcoindCfg.RPCHost != "" {
+		return
+	}
+
+	client, err := d.ntfnServer.Subscribe()
+	if err != nil {
+		d.log.Errorf("watchFilterHeaders failed to subscribe to events %v", err)
+		return
+	}
+	defer client.Cancel()
+
+	ticker := time.NewTicker(filterHeadersCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case u := <-client.Updates():
+			if _, ok := u.(ChainSyncedEvent); ok {
+				d.crossCheckFilterHeaders(ctx)
+			}
+		case <-ticker.C:
+			d.crossCheckFilterHeaders(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (d *Daemon) crossCheckFilterHeaders(ctx context.Context) {
+	chainService, cleanupFn, err := chainservice.Get(d.cfg.WorkingDir, d.breezDB)
+	if err != nil {
+		d.log.Errorf("crossCheckFilterHeaders failed to get the chain service: %v", err)
+		return
+	}
+	defer cleanupFn()
+
+	ctx, cancel := context.WithTimeout(ctx, filterHeadersCheckTimeout)
+	defer cancel()
+	mismatches, err := chainservice.CrossCheckFilterHeaders(ctx, chainService, d.cfg)
+	if err != nil {
+		d.log.Errorf("failed to cross check the filter headers: %v", err)
+		return
+	}
+	if len(mismatches) > 0 {
+		d.log.Warnf("filter headers mismatches: %+v", mismatches)
+		d.ntfnServer.SendUpdate(FilterHeaderMismatchEvent{Mismatches: mismatches})
+	}
+}
@@ -0,0 +1,67 @@
+package lnnode
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+const (
+	targetBlockInterval = 10 * time.Minute
+)
+
+// HealthCheck returns a report of the node state: chain and graph sync,
+// peers, channels, disk usage and whether the wallet can be queried.
+// Problems found while building the report are listed in its errors.
+func (d *Daemon) HealthCheck() (*data.NodeHealth, error) {
+	health := &data.NodeHealth{}
+
+	if info, err := os.Stat(d.cfg.WorkingDir); err != nil {
+		health.Errors = append(health.Errors, err.Error())
+	} else {
+		health.DiskUsage = d.du(d.cfg.WorkingDir, info)
+	}
+
+	d.Lock()
+	health.DaemonRunning = d.daemonRunning
+	d.Unlock()
+	lnclient := d.APIClient()
+	if !health.DaemonRunning || lnclient == nil {
+		health.Errors = append(health.Errors, "daemon is not running")
+		return health, nil
+	}
+
+	info, err := lnclient.GetInfo(context.Background(), &lnrpc.GetInfoRequest{})
+	if err != nil {
+		health.Errors = append(health.Errors, "GetInfo: "+err.Error())
+	} else {
+		health.BlockHeight = info.BlockHeight
+		health.BestHeaderTimestamp = info.BestHeaderTimestamp
+		health.SyncedToChain = info.SyncedToChain
+		health.SyncedToGraph = info.SyncedToGraph
+		health.NumPeers = info.NumPeers
+		health.NumActiveChannels = info.NumActiveChannels
+		health.NumInactiveChannels = info.NumInactiveChannels
+		health.NumPendingChannels = info.NumPendingChannels
+
+		// The tip is estimated from the time passed since the best header.
+		if !info.SyncedToChain && info.BestHeaderTimestamp > 0 {
+			behind := time.Since(time.Unix(info.BestHeaderTimestamp, 0)) / targetBlockInterval
+			if behind > 0 {
+				health.EstimatedBlocksBehind = uint32(behind)
+			}
+		}
+	}
+
+	balance, err := lnclient.WalletBalance(context.Background(), &lnrpc.WalletBalanceRequest{})
+	if err != nil {
+		health.Errors = append(health.Errors, "WalletBalance: "+err.Error())
+	} else {
+		health.WalletReachable = true
+		health.WalletBalance = balance.TotalBalance
+	}
+	return health, nil
+}
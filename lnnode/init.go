@@ -66,9 +66,21 @@ type Daemon struct {
 	chainNotifierClient chainrpc.ChainNotifierClient
 	invoicesClient      invoicesrpc.InvoicesClient
 	signerClient        signrpc.SignerClient
+	channelAcceptor     ChannelAcceptor
 	ntfnServer          *subscribe.Server
 	quitChan            chan struct{}
 	startBeforeSync     bool
+
+	readinessMu              sync.Mutex
+	syncStartHeaderTimestamp int64
+
+	restartMu   sync.Mutex
+	stopChan    chan struct{}
+	crashes     int
+	lastExit    time.Time
+	lastExitErr error
+	runStart    time.Time
+	runs        int
 }
 
 // NewDaemon is used to create a new daemon that wraps a lightning
@@ -85,6 +97,7 @@ func NewDaemon(cfg *config.Config, db *db.DB, startBeforeSync bool) (*Daemon, er
 		ntfnServer:      subscribe.NewServer(),
 		log:             logger,
 		startBeforeSync: startBeforeSync,
+		stopChan:        make(chan struct{}),
 	}, nil
 }
 
@@ -0,0 +1,133 @@
+// Package lnnodetest provides an in-memory implementation of lnnode.API that
+// can be used to test the services built on the daemon without running lnd.
+package lnnodetest
+
+import (
+	"errors"
+	"time"
+
+	"github.com/breez/breez/lnnode"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/breezbackuprpc"
+	"github.com/lightningnetwork/lnd/lnrpc/chainrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/submarineswaprpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+var _ lnnode.API = (*API)(nil)
+
+/*
+API is an lnnode.API backed by in-memory clients. Lightning and WalletKit are
+set to the fakes of this package by NewAPI. This package has no fakes of the
+other clients, such as the submarine swapper or the signer: they are nil as
+they are before the daemon is ready and the tests needing them set their own
+implementation. Events are delivered to the subscribers with Notify.
+*/
+type API struct {
+	Lightning     *Lightning
+	WalletKit     *WalletKit
+	SubSwap       submarineswaprpc.SubmarineSwapperClient
+	BreezBackup   breezbackuprpc.BreezBackuperClient
+	Router        routerrpc.RouterClient
+	ChainNotifier chainrpc.ChainNotifierClient
+	Invoices      invoicesrpc.InvoicesClient
+	Signer        signrpc.SignerClient
+
+	ntfnServer *subscribe.Server
+}
+
+// NewAPI creates an API with empty in-memory lightning and wallet clients.
+// Stop has to be called once done.
+func NewAPI() (*API, error) {
+	lightning, err := NewLightning()
+	if err != nil {
+		return nil, err
+	}
+	ntfnServer := subscribe.NewServer()
+	if err := ntfnServer.Start(); err != nil {
+		return nil, err
+	}
+	return &API{
+		Lightning:  lightning,
+		WalletKit:  NewWalletKit(),
+		ntfnServer: ntfnServer,
+	}, nil
+}
+
+// Stop stops delivering the events.
+func (a *API) Stop() error {
+	return a.ntfnServer.Stop()
+}
+
+// Notify sends the event, such as lnnode.DaemonReadyEvent, to the
+// subscribers.
+func (a *API) Notify(event interface{}) error {
+	return a.ntfnServer.SendUpdate(event)
+}
+
+func (a *API) SubscribeEvents() (*subscribe.Client, error) {
+	return a.ntfnServer.Subscribe()
+}
+
+func (a *API) HasActiveChannel() bool {
+	return a.Lightning.numChannels(true) > 0
+}
+
+func (a *API) IsReadyForPayment() bool {
+	return a.Lightning.numChannels(false) == a.Lightning.numChannels(true)
+}
+
+func (a *API) WaitReadyForPayment(timeout time.Duration) error {
+	if !a.IsReadyForPayment() {
+		return errors.New("timeout has exceeded while trying to process your request")
+	}
+	return nil
+}
+
+func (a *API) NodePubkey() string {
+	a.Lightning.Lock()
+	defer a.Lightning.Unlock()
+	return a.Lightning.Info.IdentityPubkey
+}
+
+func (a *API) APIClient() lnrpc.LightningClient {
+	if a.Lightning == nil {
+		return nil
+	}
+	return a.Lightning
+}
+
+func (a *API) SubSwapClient() submarineswaprpc.SubmarineSwapperClient {
+	return a.SubSwap
+}
+
+func (a *API) BreezBackupClient() breezbackuprpc.BreezBackuperClient {
+	return a.BreezBackup
+}
+
+func (a *API) RouterClient() routerrpc.RouterClient {
+	return a.Router
+}
+
+func (a *API) WalletKitClient() walletrpc.WalletKitClient {
+	if a.WalletKit == nil {
+		return nil
+	}
+	return a.WalletKit
+}
+
+func (a *API) ChainNotifierClient() chainrpc.ChainNotifierClient {
+	return a.ChainNotifier
+}
+
+func (a *API) InvoicesClient() invoicesrpc.InvoicesClient {
+	return a.Invoices
+}
+
+func (a *API) SignerClient() signrpc.SignerClient {
+	return a.Signer
+}
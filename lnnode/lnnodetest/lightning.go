@@ -0,0 +1,286 @@
+package lnnodetest
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/tv42/zbase32"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+Lightning is an in-memory lnrpc.LightningClient answering from its fields.
+Only the calls used by the services are implemented, the others panic. The
+fields are guarded by the embedded mutex once the client is in use.
+*/
+type Lightning struct {
+	lnrpc.LightningClient
+	sync.Mutex
+
+	Info     *lnrpc.GetInfoResponse
+	Channels []*lnrpc.Channel
+	Pending  *lnrpc.PendingChannelsResponse
+	Closed   []*lnrpc.ChannelCloseSummary
+	Peers    []*lnrpc.Peer
+	Wallet   *lnrpc.WalletBalanceResponse
+	Utxos    []*lnrpc.Utxo
+	Invoices []*lnrpc.Invoice
+	Payments []*lnrpc.Payment
+
+	// Routes is returned by QueryRoutes, which fails with no route if it is
+	// nil.
+	Routes *lnrpc.QueryRoutesResponse
+
+	// Addresses are returned in turn by NewAddress.
+	Addresses []string
+
+	// NodeKey signs the messages of SignMessage.
+	NodeKey *btcec.PrivateKey
+}
+
+// NewLightning creates a node with a random identity key, no channels and an
+// empty wallet.
+func NewLightning() (*Lightning, error) {
+	nodeKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+	return &Lightning{
+		Info: &lnrpc.GetInfoResponse{
+			IdentityPubkey: hex.EncodeToString(nodeKey.PubKey().SerializeCompressed()),
+		},
+		Pending: &lnrpc.PendingChannelsResponse{},
+		Wallet:  &lnrpc.WalletBalanceResponse{},
+		NodeKey: nodeKey,
+	}, nil
+}
+
+func (l *Lightning) numChannels(activeOnly bool) int {
+	l.Lock()
+	defer l.Unlock()
+	var n int
+	for _, c := range l.Channels {
+		if c.Active || !activeOnly {
+			n++
+		}
+	}
+	return n
+}
+
+func (l *Lightning) GetInfo(ctx context.Context, in *lnrpc.GetInfoRequest,
+	opts ...grpc.CallOption) (*lnrpc.GetInfoResponse, error) {
+
+	l.Lock()
+	defer l.Unlock()
+	info := *l.Info
+	info.NumActiveChannels, info.NumInactiveChannels = 0, 0
+	for _, c := range l.Channels {
+		if c.Active {
+			info.NumActiveChannels++
+		} else {
+			info.NumInactiveChannels++
+		}
+	}
+	info.NumPendingChannels = uint32(len(l.Pending.PendingOpenChannels))
+	info.NumPeers = uint32(len(l.Peers))
+	return &info, nil
+}
+
+func (l *Lightning) ListChannels(ctx context.Context, in *lnrpc.ListChannelsRequest,
+	opts ...grpc.CallOption) (*lnrpc.ListChannelsResponse, error) {
+
+	l.Lock()
+	defer l.Unlock()
+	var channels []*lnrpc.Channel
+	for _, c := range l.Channels {
+		if in.ActiveOnly && !c.Active || in.InactiveOnly && c.Active ||
+			in.PrivateOnly && !c.Private || in.PublicOnly && c.Private {
+			continue
+		}
+		channels = append(channels, c)
+	}
+	return &lnrpc.ListChannelsResponse{Channels: channels}, nil
+}
+
+func (l *Lightning) PendingChannels(ctx context.Context, in *lnrpc.PendingChannelsRequest,
+	opts ...grpc.CallOption) (*lnrpc.PendingChannelsResponse, error) {
+
+	l.Lock()
+	defer l.Unlock()
+	return l.Pending, nil
+}
+
+func (l *Lightning) ClosedChannels(ctx context.Context, in *lnrpc.ClosedChannelsRequest,
+	opts ...grpc.CallOption) (*lnrpc.ClosedChannelsResponse, error) {
+
+	l.Lock()
+	defer l.Unlock()
+	return &lnrpc.ClosedChannelsResponse{Channels: l.Closed}, nil
+}
+
+func (l *Lightning) ListPeers(ctx context.Context, in *lnrpc.ListPeersRequest,
+	opts ...grpc.CallOption) (*lnrpc.ListPeersResponse, error) {
+
+	l.Lock()
+	defer l.Unlock()
+	return &lnrpc.ListPeersResponse{Peers: l.Peers}, nil
+}
+
+func (l *Lightning) WalletBalance(ctx context.Context, in *lnrpc.WalletBalanceRequest,
+	opts ...grpc.CallOption) (*lnrpc.WalletBalanceResponse, error) {
+
+	l.Lock()
+	defer l.Unlock()
+	return l.Wallet, nil
+}
+
+func (l *Lightning) ChannelBalance(ctx context.Context, in *lnrpc.ChannelBalanceRequest,
+	opts ...grpc.CallOption) (*lnrpc.ChannelBalanceResponse, error) {
+
+	l.Lock()
+	defer l.Unlock()
+	var balance, pending int64
+	for _, c := range l.Channels {
+		balance += c.LocalBalance
+	}
+	for _, c := range l.Pending.PendingOpenChannels {
+		pending += c.Channel.LocalBalance
+	}
+	return &lnrpc.ChannelBalanceResponse{Balance: balance, PendingOpenBalance: pending}, nil
+}
+
+func (l *Lightning) ListUnspent(ctx context.Context, in *lnrpc.ListUnspentRequest,
+	opts ...grpc.CallOption) (*lnrpc.ListUnspentResponse, error) {
+
+	l.Lock()
+	defer l.Unlock()
+	var utxos []*lnrpc.Utxo
+	for _, u := range l.Utxos {
+		if u.Confirmations >= int64(in.MinConfs) && u.Confirmations <= int64(in.MaxConfs) {
+			utxos = append(utxos, u)
+		}
+	}
+	return &lnrpc.ListUnspentResponse{Utxos: utxos}, nil
+}
+
+func (l *Lightning) NewAddress(ctx context.Context, in *lnrpc.NewAddressRequest,
+	opts ...grpc.CallOption) (*lnrpc.NewAddressResponse, error) {
+
+	l.Lock()
+	defer l.Unlock()
+	if len(l.Addresses) == 0 {
+		return nil, errors.New("no more addresses")
+	}
+	address := l.Addresses[0]
+	l.Addresses = l.Addresses[1:]
+	return &lnrpc.NewAddressResponse{Address: address}, nil
+}
+
+// AddInvoice adds an open invoice with a random preimage unless one is set.
+// The payment request is left empty.
+func (l *Lightning) AddInvoice(ctx context.Context, in *lnrpc.Invoice,
+	opts ...grpc.CallOption) (*lnrpc.AddInvoiceResponse, error) {
+
+	l.Lock()
+	defer l.Unlock()
+	invoice := *in
+	if len(invoice.RPreimage) == 0 {
+		invoice.RPreimage = make([]byte, 32)
+		if _, err := rand.Read(invoice.RPreimage); err != nil {
+			return nil, err
+		}
+	}
+	hash := sha256.Sum256(invoice.RPreimage)
+	invoice.RHash = hash[:]
+	invoice.State = lnrpc.Invoice_OPEN
+	invoice.AddIndex = uint64(len(l.Invoices) + 1)
+	l.Invoices = append(l.Invoices, &invoice)
+	return &lnrpc.AddInvoiceResponse{
+		RHash:          invoice.RHash,
+		PaymentRequest: invoice.PaymentRequest,
+		AddIndex:       invoice.AddIndex,
+	}, nil
+}
+
+func (l *Lightning) LookupInvoice(ctx context.Context, in *lnrpc.PaymentHash,
+	opts ...grpc.CallOption) (*lnrpc.Invoice, error) {
+
+	l.Lock()
+	defer l.Unlock()
+	for _, invoice := range l.Invoices {
+		if string(invoice.RHash) == string(in.RHash) {
+			return invoice, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "unable to locate invoice")
+}
+
+func (l *Lightning) ListInvoices(ctx context.Context, in *lnrpc.ListInvoiceRequest,
+	opts ...grpc.CallOption) (*lnrpc.ListInvoiceResponse, error) {
+
+	l.Lock()
+	defer l.Unlock()
+	var invoices []*lnrpc.Invoice
+	for _, invoice := range l.Invoices {
+		if invoice.AddIndex <= in.IndexOffset {
+			continue
+		}
+		if in.PendingOnly && invoice.State != lnrpc.Invoice_OPEN && invoice.State != lnrpc.Invoice_ACCEPTED {
+			continue
+		}
+		invoices = append(invoices, invoice)
+	}
+	return &lnrpc.ListInvoiceResponse{Invoices: invoices}, nil
+}
+
+func (l *Lightning) ListPayments(ctx context.Context, in *lnrpc.ListPaymentsRequest,
+	opts ...grpc.CallOption) (*lnrpc.ListPaymentsResponse, error) {
+
+	l.Lock()
+	defer l.Unlock()
+	var payments []*lnrpc.Payment
+	for _, p := range l.Payments {
+		if p.PaymentIndex <= in.IndexOffset {
+			continue
+		}
+		if !in.IncludeIncomplete && p.Status != lnrpc.Payment_SUCCEEDED {
+			continue
+		}
+		payments = append(payments, p)
+	}
+	return &lnrpc.ListPaymentsResponse{Payments: payments}, nil
+}
+
+func (l *Lightning) QueryRoutes(ctx context.Context, in *lnrpc.QueryRoutesRequest,
+	opts ...grpc.CallOption) (*lnrpc.QueryRoutesResponse, error) {
+
+	l.Lock()
+	defer l.Unlock()
+	if l.Routes == nil {
+		return nil, status.Error(codes.Unknown, "unable to find a path to destination")
+	}
+	return l.Routes, nil
+}
+
+// SignMessage signs the message with the node key the way lnd does.
+func (l *Lightning) SignMessage(ctx context.Context, in *lnrpc.SignMessageRequest,
+	opts ...grpc.CallOption) (*lnrpc.SignMessageResponse, error) {
+
+	l.Lock()
+	defer l.Unlock()
+	digest := chainhash.DoubleHashB(append([]byte("Lightning Signed Message:"), in.Msg...))
+	sig, err := btcec.SignCompact(btcec.S256(), l.NodeKey, digest, true)
+	if err != nil {
+		return nil, err
+	}
+	return &lnrpc.SignMessageResponse{Signature: zbase32.EncodeToString(sig)}, nil
+}
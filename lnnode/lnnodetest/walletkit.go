@@ -0,0 +1,58 @@
+package lnnodetest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"google.golang.org/grpc"
+)
+
+/*
+WalletKit is an in-memory walletrpc.WalletKitClient answering from its
+fields. Only the calls used by the services are implemented, the others
+panic.
+*/
+type WalletKit struct {
+	walletrpc.WalletKitClient
+	sync.Mutex
+
+	// FeePerKw is the fee rate returned by EstimateFee for every target.
+	FeePerKw int64
+
+	// Sweeps are the raw sweep transactions returned by ListSweeps.
+	Sweeps []string
+}
+
+// NewWalletKit creates a wallet with a 1 sat/vbyte fee rate.
+func NewWalletKit() *WalletKit {
+	return &WalletKit{FeePerKw: 253}
+}
+
+func (w *WalletKit) EstimateFee(ctx context.Context, in *walletrpc.EstimateFeeRequest,
+	opts ...grpc.CallOption) (*walletrpc.EstimateFeeResponse, error) {
+
+	w.Lock()
+	defer w.Unlock()
+	return &walletrpc.EstimateFeeResponse{SatPerKw: w.FeePerKw}, nil
+}
+
+func (w *WalletKit) ListSweeps(ctx context.Context, in *walletrpc.ListSweepsRequest,
+	opts ...grpc.CallOption) (*walletrpc.ListSweepsResponse, error) {
+
+	w.Lock()
+	defer w.Unlock()
+	if !in.Verbose {
+		return &walletrpc.ListSweepsResponse{
+			Sweeps: &walletrpc.ListSweepsResponse_TransactionIds{
+				TransactionIds: &walletrpc.ListSweepsResponse_TransactionIDs{},
+			},
+		}, nil
+	}
+	return &walletrpc.ListSweepsResponse{
+		Sweeps: &walletrpc.ListSweepsResponse_TransactionDetails{
+			TransactionDetails: &lnrpc.TransactionDetails{},
+		},
+	}, nil
+}
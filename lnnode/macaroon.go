@@ -0,0 +1,67 @@
+package lnnode
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/macaroons"
+	macaroon "gopkg.in/macaroon.v2"
+)
+
+// BakeMacaroon bakes a hex encoded macaroon limited to the given permissions
+// in the entity:action form, for example "info:read" or "invoices:write", so
+// external tools can be granted limited access to the node. A positive ttl
+// makes the macaroon expire after that duration.
+func (d *Daemon) BakeMacaroon(permissions []string, ttl time.Duration) (string, error) {
+	if len(permissions) == 0 {
+		return "", errors.New("no permissions")
+	}
+	var rpcPermissions []*lnrpc.MacaroonPermission
+	for _, p := range permissions {
+		s := strings.Split(p, ":")
+		if len(s) != 2 || s[0] == "" || s[1] == "" {
+			return "", fmt.Errorf("malformed permission %v", p)
+		}
+		rpcPermissions = append(rpcPermissions, &lnrpc.MacaroonPermission{
+			Entity: s[0],
+			Action: s[1],
+		})
+	}
+
+	client := d.APIClient()
+	if client == nil {
+		return "", errors.New("daemon is not ready")
+	}
+	response, err := client.BakeMacaroon(context.Background(), &lnrpc.BakeMacaroonRequest{
+		Permissions: rpcPermissions,
+	})
+	if err != nil {
+		return "", err
+	}
+	if ttl <= 0 {
+		return response.Macaroon, nil
+	}
+
+	macBytes, err := hex.DecodeString(response.Macaroon)
+	if err != nil {
+		return "", err
+	}
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return "", err
+	}
+	mac, err = macaroons.AddConstraints(mac, macaroons.TimeoutConstraint(int64(ttl.Seconds())))
+	if err != nil {
+		return "", err
+	}
+	macBytes, err = mac.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(macBytes), nil
+}
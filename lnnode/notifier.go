@@ -5,6 +5,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/breez/breez/chainservice"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/backuprpc"
 	"github.com/lightningnetwork/lnd/lnrpc/breezbackuprpc"
@@ -46,9 +47,19 @@ type InvoiceEvent struct {
 	*lnrpc.Invoice
 }
 
+// HtlcEvent is sent whenever an htlc is forwarded, settled or failed.
+type HtlcEvent struct {
+	*routerrpc.HtlcEvent
+}
+
 // ChainSyncedEvent is sent when the chain gets into synced state.
 type ChainSyncedEvent struct{}
 
+// ChainSyncProgressEvent is sent periodically while the chain is syncing.
+type ChainSyncProgressEvent struct {
+	*chainservice.SyncProgress
+}
+
 // ResumeEvent is sent when the app resumes.
 type ResumeEvent struct{}
 
@@ -95,14 +106,19 @@ func (d *Daemon) startSubscriptions() error {
 	backupEventClient := backuprpc.NewBackupClient(grpcCon)
 	ctx, cancel := context.WithCancel(context.Background())
 
-	d.wg.Add(7)
+	d.wg.Add(12)
 	go d.subscribeChannels(d.lightningClient, ctx)
+	go d.subscribeHtlcEvents(ctx, d.routerClient)
 	go d.subscribePeers(d.lightningClient, ctx)
 	go d.subscribeTransactions(ctx)
 	go d.subscribeInvoices(ctx)
 	go d.subscribeChannelAcceptor(ctx, d.lightningClient)
 	go d.watchBackupEvents(backupEventClient, ctx)
 	go d.syncToChain(ctx)
+	go d.watchReadiness(ctx)
+	go d.watchStorage(ctx)
+	go d.watchReorgs(ctx)
+	go d.watchFilterHeaders(ctx)
 
 	// cancel subscriptions on quit
 	go func() {
@@ -141,12 +157,18 @@ func (d *Daemon) subscribeChannelAcceptor(ctx context.Context, client lnrpc.Ligh
 		}
 		private := request.ChannelFlags&uint32(lnwire.FFAnnounceChannel) == 0
 		d.log.Infof("channel creation requested from node: %v private: %v", request.NodePubkey, private)
-		err = channelAcceptorClient.Send(&lnrpc.ChannelAcceptResponse{
+		response := &lnrpc.ChannelAcceptResponse{
 			PendingChanId: request.PendingChanId,
-			Accept:        private,
-		})
+			Accept:        true,
+		}
+		if err := d.getChannelAcceptor().AcceptChannel(request); err != nil {
+			d.log.Infof("rejecting channel from node: %x: %v", request.NodePubkey, err)
+			response.Accept = false
+			response.Error = err.Error()
+		}
+		err = channelAcceptorClient.Send(response)
 		if err != nil {
-			d.log.Errorf("Error in channelAcceptorClient.Send(%v, %v): %v", request.PendingChanId, private, err)
+			d.log.Errorf("Error in channelAcceptorClient.Send(%v, %v): %v", request.PendingChanId, response.Accept, err)
 			return err
 		}
 	}
@@ -212,6 +234,30 @@ func (d *Daemon) subscribeChannels(client lnrpc.LightningClient, ctx context.Con
 	}
 }
 
+func (d *Daemon) subscribeHtlcEvents(ctx context.Context, client routerrpc.RouterClient) error {
+	defer d.wg.Done()
+
+	stream, err := client.SubscribeHtlcEvents(ctx, &routerrpc.SubscribeHtlcEventsRequest{})
+	if err != nil {
+		d.log.Errorf("Failed to subscribe htlc events %v", err)
+		return err
+	}
+
+	d.log.Infof("Htlc events subscription created")
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF || ctx.Err() == context.Canceled {
+			d.log.Errorf("subscribeHtlcEvents cancelled, shutting down")
+			return err
+		}
+		if err != nil {
+			d.log.Errorf("subscribeHtlcEvents failed to get notification %v", err)
+			return err
+		}
+		d.ntfnServer.SendUpdate(HtlcEvent{event})
+	}
+}
+
 func (d *Daemon) subscribeTransactions(ctx context.Context) error {
 	defer d.wg.Done()
 
@@ -303,6 +349,13 @@ func (d *Daemon) syncToChain(ctx context.Context) error {
 			d.log.Errorf("Failed to set last header timestamp")
 		}
 
+		if progress, err := chainservice.GetSyncProgress(); err == nil {
+			if chainInfo.SyncedToChain {
+				progress.Percent = 100
+			}
+			d.ntfnServer.SendUpdate(ChainSyncProgressEvent{progress})
+		}
+
 		if chainInfo.SyncedToChain {
 			d.log.Infof("Synchronized to chain finshed BlockHeight=%v", chainInfo.BlockHeight)
 			break
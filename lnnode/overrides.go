@@ -0,0 +1,80 @@
+package lnnode
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/lightningnetwork/lnd"
+)
+
+// managedOptions are the lnd options breez sets itself and that can't be
+// overridden since changing them would break the node.
+var managedOptions = map[string]struct{}{
+	"lnddir":               {},
+	"configfile":           {},
+	"datadir":              {},
+	"logdir":               {},
+	"tlscertpath":          {},
+	"tlskeypath":           {},
+	"adminmacaroonpath":    {},
+	"rpclisten":            {},
+	"restlisten":           {},
+	"no-macaroons":         {},
+	"tlsextraip":           {},
+	"norest":               {},
+	"no-rest-tls":          {},
+	"readonlymacaroonpath": {},
+	"invoicemacaroonpath":  {},
+	"bitcoin.active":       {},
+	"bitcoin.mainnet":      {},
+	"bitcoin.testnet":      {},
+	"bitcoin.simnet":       {},
+	"bitcoin.regtest":      {},
+	"bitcoin.node":         {},
+	"litecoin.active":      {},
+}
+
+// overrideNamePattern matches the lnd option names as written in lnd.conf.
+var overrideNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9.\-]*$`)
+
+/*
+applyConfigOverrides sets the user supplied lnd options on the config. The keys
+are the lnd option names as in lnd.conf, such as maxpendingchannels or
+numgraphsyncpeers, and the values are parsed as in lnd.conf, so bool options
+take true, false, 1 or 0. Unknown options, invalid values and options managed
+by breez are rejected.
+*/
+func applyConfigOverrides(cfg *lnd.Config, overrides map[string]string) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(overrides))
+	for key := range overrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var ini strings.Builder
+	for _, key := range keys {
+		name := strings.ToLower(strings.TrimLeft(strings.TrimSpace(key), "-"))
+		if !overrideNamePattern.MatchString(name) {
+			return fmt.Errorf("invalid lnd option %v", key)
+		}
+		if _, ok := managedOptions[name]; ok {
+			return fmt.Errorf("lnd option %v can't be overridden", key)
+		}
+		value := overrides[key]
+		if strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("invalid value of lnd option %v", key)
+		}
+		fmt.Fprintf(&ini, "%v=%v\n", name, value)
+	}
+	parser := flags.NewParser(cfg, flags.None)
+	if err := flags.NewIniParser(parser).Parse(strings.NewReader(ini.String())); err != nil {
+		return fmt.Errorf("invalid lnd option override: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package lnnode
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd"
+)
+
+func TestApplyConfigOverrides(t *testing.T) {
+	cfg := lnd.DefaultConfig()
+	err := applyConfigOverrides(&cfg, map[string]string{
+		"accept-keysend":          "true",
+		"protocol.wumbo-channels": "1",
+		"maxpendingchannels":      "5",
+	})
+	if err != nil {
+		t.Fatalf("applyConfigOverrides: %v", err)
+	}
+	if !cfg.AcceptKeySend || !cfg.ProtocolOptions.WumboChans || cfg.MaxPendingChannels != 5 {
+		t.Fatalf("overrides not applied: keysend=%v wumbo=%v maxpendingchannels=%v",
+			cfg.AcceptKeySend, cfg.ProtocolOptions.WumboChans, cfg.MaxPendingChannels)
+	}
+	if err := applyConfigOverrides(&cfg, map[string]string{"accept-keysend": "false"}); err != nil {
+		t.Fatalf("applyConfigOverrides: %v", err)
+	}
+	if cfg.AcceptKeySend {
+		t.Fatalf("accept-keysend=false not applied")
+	}
+
+	for _, overrides := range []map[string]string{
+		{"norest": "true"},
+		{"rpclisten": "0.0.0.0:10009"},
+		{"unknown-option": "1"},
+		{"accept-keysend": "maybe"},
+		{"maxpendingchannels": "5\nnorest=1"},
+	} {
+		cfg := lnd.DefaultConfig()
+		if err := applyConfigOverrides(&cfg, overrides); err == nil {
+			t.Errorf("applyConfigOverrides(%v) should fail", overrides)
+		}
+	}
+}
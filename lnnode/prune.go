@@ -0,0 +1,155 @@
+package lnnode
+
+import (
+	"errors"
+	"time"
+
+	"github.com/breez/breez/channeldbservice"
+	"github.com/btcsuite/btcwallet/walletdb/bdb"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	pruneInvoicesPage = 1000
+)
+
+var (
+	paymentsRootBucket      = []byte("payments-root-bucket")
+	paymentsIndexBucket     = []byte("payments-index-bucket")
+	paymentSequenceKey      = []byte("payment-sequence-key")
+	duplicatePaymentsBucket = []byte("payment-duplicate-bucket")
+)
+
+/*
+PruneChannelDB keeps the lnd database small by deleting the failed payments
+and the invoices settled more than olderThan ago. Only the invoices already
+recorded in the breez payments are deleted, so the payments list is not
+affected, and the succeeded payments are kept for lnd to refuse paying the
+same invoice twice.
+lnd doesn't expect its db to change underneath it so this fails while the
+daemon is running. The daemon also prunes on every start when
+PruneCfg.InvoicesMaxAge is set.
+It returns the number of deleted payments and invoices.
+*/
+func (d *Daemon) PruneChannelDB(olderThan time.Duration) (int, int, error) {
+	d.Lock()
+	defer d.Unlock()
+	if d.daemonRunning {
+		return 0, 0, errors.New("channel.db can't be pruned while the daemon is running")
+	}
+	chanDB, cleanup, err := channeldbservice.Get(d.cfg.WorkingDir)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer cleanup()
+	return d.pruneChanDB(chanDB, olderThan)
+}
+
+func (d *Daemon) pruneChanDB(chanDB *channeldb.DB, olderThan time.Duration) (int, int, error) {
+	cutoff := time.Now().Add(-olderThan)
+	deletedPayments, err := pruneFailedPayments(chanDB, cutoff)
+	if err != nil {
+		return 0, 0, err
+	}
+	_, lastSettledIndex := d.breezDB.FetchPaymentsSyncInfo()
+	deletedInvoices, err := pruneSettledInvoices(chanDB, cutoff, lastSettledIndex)
+	if err != nil {
+		return deletedPayments, 0, err
+	}
+	d.log.Infof("pruned %v payments and %v invoices", deletedPayments, deletedInvoices)
+	return deletedPayments, deletedInvoices, nil
+}
+
+// pruneFailedPayments deletes the payments that failed and were created
+// before the cutoff.
+func pruneFailedPayments(chanDB *channeldb.DB, cutoff time.Time) (int, error) {
+	payments, err := chanDB.FetchPayments()
+	if err != nil {
+		return 0, err
+	}
+	var failed [][]byte
+	for _, p := range payments {
+		if p.Status == channeldb.StatusFailed && p.Info.CreationTime.Before(cutoff) {
+			hash := p.Info.PaymentHash
+			failed = append(failed, hash[:])
+		}
+	}
+	if len(failed) == 0 {
+		return 0, nil
+	}
+
+	boltDB, err := bdb.UnderlineDB(chanDB.Backend)
+	if err != nil {
+		return 0, err
+	}
+	var deleted int
+	err = boltDB.Update(func(tx *bbolt.Tx) error {
+		payments := tx.Bucket(paymentsRootBucket)
+		if payments == nil {
+			return nil
+		}
+		index := tx.Bucket(paymentsIndexBucket)
+		for _, hash := range failed {
+			// Payments with duplicates come from old lnd versions,
+			// leave them to lnd.
+			bucket := payments.Bucket(hash)
+			if bucket == nil || bucket.Bucket(duplicatePaymentsBucket) != nil {
+				continue
+			}
+			if seqNum := bucket.Get(paymentSequenceKey); seqNum != nil && index != nil {
+				if err := index.Delete(seqNum); err != nil {
+					return err
+				}
+			}
+			if err := payments.DeleteBucket(hash); err != nil {
+				return err
+			}
+			deleted++
+		}
+		return nil
+	})
+	return deleted, err
+}
+
+// pruneSettledInvoices deletes the invoices settled before the cutoff which
+// are already recorded in breezDB.
+func pruneSettledInvoices(chanDB *channeldb.DB, cutoff time.Time, lastSettledIndex uint64) (int, error) {
+	var refs []channeldb.InvoiceDeleteRef
+	query := channeldb.InvoiceQuery{NumMaxInvoices: pruneInvoicesPage}
+	for {
+		slice, err := chanDB.QueryInvoices(query)
+		if err != nil {
+			return 0, err
+		}
+		for _, invoice := range slice.Invoices {
+			if invoice.State != channeldb.ContractSettled ||
+				invoice.SettleIndex > lastSettledIndex ||
+				!invoice.SettleDate.Before(cutoff) ||
+				invoice.Terms.PaymentPreimage == nil {
+				continue
+			}
+			ref := channeldb.InvoiceDeleteRef{
+				PayHash:     invoice.Terms.PaymentPreimage.Hash(),
+				AddIndex:    invoice.AddIndex,
+				SettleIndex: invoice.SettleIndex,
+			}
+			if invoice.Terms.PaymentAddr != channeldb.BlankPayAddr {
+				payAddr := invoice.Terms.PaymentAddr
+				ref.PayAddr = &payAddr
+			}
+			refs = append(refs, ref)
+		}
+		if len(slice.Invoices) < pruneInvoicesPage {
+			break
+		}
+		query.IndexOffset = slice.LastIndexOffset
+	}
+	if len(refs) == 0 {
+		return 0, nil
+	}
+	if err := chanDB.DeleteInvoice(refs); err != nil {
+		return 0, err
+	}
+	return len(refs), nil
+}
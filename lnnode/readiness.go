@@ -0,0 +1,161 @@
+package lnnode
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/golang/protobuf/proto"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+const (
+	// readinessPollInterval is how often the readiness state is refreshed
+	// while the node is not ready, to follow the chain sync progress.
+	readinessPollInterval = 3 * time.Second
+)
+
+// ReadinessEvent is sent whenever the readiness state of the node changes.
+type ReadinessEvent struct {
+	*data.ReadinessState
+}
+
+// ReadinessState returns the current readiness state of the node.
+func (d *Daemon) ReadinessState() (*data.ReadinessState, error) {
+	lnclient := d.APIClient()
+	if lnclient == nil {
+		return &data.ReadinessState{}, nil
+	}
+	info, err := lnclient.GetInfo(context.Background(), &lnrpc.GetInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	state := &data.ReadinessState{
+		ChainSynced:      info.SyncedToChain,
+		BlockHeight:      info.BlockHeight,
+		GraphSynced:      info.SyncedToGraph,
+		ConnectedPeers:   info.NumPeers,
+		ActiveChannels:   info.NumActiveChannels,
+		InactiveChannels: info.NumInactiveChannels,
+		PendingChannels:  info.NumPendingChannels,
+		RouterReady:      d.RouterClient() != nil,
+	}
+	state.ChainSyncProgress = d.chainSyncProgress(info)
+	state.ReadyForPayment = state.RouterReady && state.InactiveChannels == 0
+	return state, nil
+}
+
+// chainSyncProgress estimates the percentage of the chain synced since the
+// daemon started from the time left between the best header and now.
+func (d *Daemon) chainSyncProgress(info *lnrpc.GetInfoResponse) int32 {
+	if info.SyncedToChain {
+		return 100
+	}
+	d.readinessMu.Lock()
+	defer d.readinessMu.Unlock()
+	if d.syncStartHeaderTimestamp == 0 || info.BestHeaderTimestamp < d.syncStartHeaderTimestamp {
+		d.syncStartHeaderTimestamp = info.BestHeaderTimestamp
+	}
+	total := time.Now().Unix() - d.syncStartHeaderTimestamp
+	if total <= 0 {
+		return 99
+	}
+	progress := int32((info.BestHeaderTimestamp - d.syncStartHeaderTimestamp) * 100 / total)
+	if progress > 99 {
+		progress = 99
+	}
+	return progress
+}
+
+// watchReadiness sends a ReadinessEvent whenever the readiness state changes.
+// The state is polled until the node is synced and ready, then refreshed on
+// channel and peer events only.
+func (d *Daemon) watchReadiness(ctx context.Context) error {
+	defer d.wg.Done()
+
+	client, err := d.ntfnServer.Subscribe()
+	if err != nil {
+		d.log.Errorf("watchReadiness failed to subscribe to events %v", err)
+		return err
+	}
+	defer client.Cancel()
+
+	ticker := time.NewTicker(readinessPollInterval)
+	defer ticker.Stop()
+
+	var last *data.ReadinessState
+	refresh := func() {
+		state, err := d.ReadinessState()
+		if err != nil {
+			d.log.Errorf("watchReadiness failed to get the readiness state %v", err)
+			return
+		}
+		if last != nil && proto.Equal(last, state) {
+			return
+		}
+		last = state
+		d.log.Infof("readiness state changed: %v", state)
+		d.ntfnServer.SendUpdate(ReadinessEvent{state})
+	}
+	refresh()
+	for {
+		select {
+		case <-ticker.C:
+			if last == nil || !last.ChainSynced || !last.GraphSynced || !last.ReadyForPayment {
+				refresh()
+			}
+		case u := <-client.Updates():
+			switch u.(type) {
+			case ChannelEvent, PeerEvent, ChainSyncedEvent:
+				refresh()
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// WaitReadyForPayment is waiting untill we are ready to pay
+func (d *Daemon) WaitReadyForPayment(timeout time.Duration) error {
+	client, err := d.ntfnServer.Subscribe()
+	if err != nil {
+		return err
+	}
+	defer client.Cancel()
+
+	if d.IsReadyForPayment() {
+		return nil
+	}
+
+	d.log.Infof("WaitReadyForPayment - not yet ready for payment, waiting...")
+	timeoutTimer := time.After(timeout)
+	for {
+		select {
+		case event := <-client.Updates():
+			if readiness, ok := event.(ReadinessEvent); ok {
+				d.log.Infof("WaitReadyForPayment got readiness event %v", readiness.ReadyForPayment)
+				if readiness.ReadyForPayment {
+					return nil
+				}
+			}
+		case <-timeoutTimer:
+			if d.IsReadyForPayment() {
+				return nil
+			}
+			d.log.Info("WaitReadyForPayment got timeout event")
+			return fmt.Errorf("timeout has exceeded while trying to process your request")
+		}
+	}
+}
+
+// IsReadyForPayment returns true if we can pay
+func (d *Daemon) IsReadyForPayment() bool {
+	state, err := d.ReadinessState()
+	if err != nil {
+		d.log.Errorf("Error in ReadinessState(): %v", err)
+		return false
+	}
+	return state.ReadyForPayment
+}
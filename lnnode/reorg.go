@@ -0,0 +1,52 @@
+package lnnode
+
+import (
+	"context"
+
+	"github.com/breez/breez/chainservice"
+)
+
+// ChainReorgEvent is sent when the chain service detects a chain
+// reorganization, so recently confirmed transactions can be verified again.
+type ChainReorgEvent struct {
+	*chainservice.Reorg
+}
+
+// watchReorgs follows the chain service blocks and sends a ChainReorgEvent
+// for every reorganization.
+func (d *Daemon) watchReorgs(ctx context.Context) {
+	defer d.wg.Done()
+	if d.cfg.BitcoindCfg.RPCHost != "" {
+		return
+	}
+
+	chainService, cleanupFn, err := chainservice.Get(d.cfg.WorkingDir, d.breezDB)
+	if err != nil {
+		d.log.Errorf("watchReorgs failed to get the chain service: %v", err)
+		return
+	}
+	defer cleanupFn()
+	sub, err := chainservice.SubscribeBlocks(chainService)
+	if err != nil {
+		d.log.Errorf("watchReorgs failed to subscribe to blocks: %v", err)
+		return
+	}
+	defer sub.Cancel()
+
+	var detector chainservice.ReorgDetector
+	for {
+		select {
+		case ntfn, ok := <-sub.Notifications:
+			if !ok {
+				return
+			}
+			if reorg := detector.Process(ntfn); reorg != nil {
+				d.log.Warnf("chain reorg of depth %v from %v:%v to %v:%v", reorg.Depth,
+					reorg.OldTip.Height, reorg.OldTip.Hash, reorg.NewTip.Height, reorg.NewTip.Hash)
+				d.ntfnServer.SendUpdate(ChainReorgEvent{reorg})
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
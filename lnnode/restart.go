@@ -0,0 +1,126 @@
+package lnnode
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	restartBaseBackoff = 2 * time.Second
+	restartMaxBackoff  = 2 * time.Minute
+
+	// stableRunDuration is how long the daemon has to run for its exit not
+	// to count as a crash.
+	stableRunDuration = 5 * time.Minute
+
+	// maxCrashRestarts is the number of crashes in a row after which the
+	// daemon is no longer restarted.
+	maxCrashRestarts = 5
+)
+
+// ErrDaemonCrashLoop is returned by RestartDaemon once the daemon crashed
+// maxCrashRestarts times in a row.
+var ErrDaemonCrashLoop = errors.New("daemon keeps crashing")
+
+// DaemonCrashLoopEvent is sent when the daemon crashed too many times in a
+// row and won't be restarted until ResetCrashLoop is called.
+type DaemonCrashLoopEvent struct {
+	Crashes   int
+	LastError error
+}
+
+// onDaemonStart records the start of lnd.
+func (d *Daemon) onDaemonStart(runStart time.Time) {
+	d.restartMu.Lock()
+	defer d.restartMu.Unlock()
+	d.runStart = runStart
+	d.runs++
+}
+
+// Uptime returns how long ago the daemon was started and how long the
+// current lnd run has been going, zero if it isn't running.
+func (d *Daemon) Uptime() (uptime, runUptime time.Duration) {
+	if atomic.LoadInt32(&d.started) == 0 {
+		return 0, 0
+	}
+	d.Lock()
+	running := d.daemonRunning
+	d.Unlock()
+
+	d.restartMu.Lock()
+	defer d.restartMu.Unlock()
+	if running && !d.runStart.IsZero() {
+		runUptime = time.Since(d.runStart)
+	}
+	return time.Since(d.startTime), runUptime
+}
+
+// Restarts returns the number of times lnd was restarted since the daemon
+// was started.
+func (d *Daemon) Restarts() int {
+	d.restartMu.Lock()
+	defer d.restartMu.Unlock()
+	if d.runs == 0 {
+		return 0
+	}
+	return d.runs - 1
+}
+
+// onDaemonExit records the exit of lnd and counts it as a crash unless it
+// was stopped or ran long enough.
+func (d *Daemon) onDaemonExit(exitErr error, ranFor time.Duration, stopped bool) {
+	d.restartMu.Lock()
+	defer d.restartMu.Unlock()
+
+	d.lastExit = time.Now()
+	if stopped || ranFor >= stableRunDuration {
+		d.crashes = 0
+		d.lastExitErr = nil
+		return
+	}
+	if exitErr == nil {
+		exitErr = fmt.Errorf("daemon exited after %v", ranFor)
+	}
+	d.crashes++
+	d.lastExitErr = exitErr
+	d.log.Errorf("daemon crashed %v times in a row, last error: %v", d.crashes, exitErr)
+	if d.crashes == maxCrashRestarts {
+		d.ntfnServer.SendUpdate(DaemonCrashLoopEvent{
+			Crashes:   d.crashes,
+			LastError: exitErr,
+		})
+	}
+}
+
+// restartDelay returns how long to wait before restarting the daemon. The
+// delay grows exponentially with the number of crashes in a row and has a
+// random jitter so restarts don't synchronize with whatever made lnd crash.
+func (d *Daemon) restartDelay() (time.Duration, error) {
+	d.restartMu.Lock()
+	defer d.restartMu.Unlock()
+
+	if d.crashes == 0 {
+		return 0, nil
+	}
+	if d.crashes >= maxCrashRestarts {
+		return 0, fmt.Errorf("%w: %v", ErrDaemonCrashLoop, d.lastExitErr)
+	}
+	backoff := restartBaseBackoff << (d.crashes - 1)
+	if backoff > restartMaxBackoff {
+		backoff = restartMaxBackoff
+	}
+	backoff += time.Duration(rand.Int63n(int64(backoff)/2 + 1))
+	return backoff - time.Since(d.lastExit), nil
+}
+
+// ResetCrashLoop forgets the previous crashes so the daemon can be restarted
+// again, for example after a recovery action was taken.
+func (d *Daemon) ResetCrashLoop() {
+	d.restartMu.Lock()
+	defer d.restartMu.Unlock()
+	d.crashes = 0
+	d.lastExitErr = nil
+}
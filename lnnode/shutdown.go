@@ -0,0 +1,88 @@
+package lnnode
+
+import (
+	"os"
+	"path"
+	"runtime/pprof"
+	"sync"
+	"time"
+)
+
+const (
+	defaultShutdownTimeout = 20 * time.Second
+	shutdownDumpFile       = "shutdown-dump.txt"
+)
+
+// subsystemCleanup releases the subsystems used by lnd exactly once, when
+// lnd exits.
+type subsystemCleanup struct {
+	sync.Mutex
+	fns  []func() error
+	once sync.Once
+}
+
+func (c *subsystemCleanup) add(fn func() error) {
+	c.Lock()
+	defer c.Unlock()
+	c.fns = append(c.fns, fn)
+}
+
+func (c *subsystemCleanup) run() {
+	c.once.Do(func() {
+		c.Lock()
+		defer c.Unlock()
+		for _, fn := range c.fns {
+			fn()
+		}
+	})
+}
+
+func (d *Daemon) shutdownTimeout() time.Duration {
+	if d.cfg.DaemonCfg.ShutdownTimeout > 0 {
+		return d.cfg.DaemonCfg.ShutdownTimeout
+	}
+	return defaultShutdownTimeout
+}
+
+// waitForShutdown waits for the daemon goroutines to exit and returns false
+// if they didn't within the shutdown timeout.
+func (d *Daemon) waitForShutdown() bool {
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(d.shutdownTimeout()):
+		return false
+	}
+}
+
+// dumpStuckShutdown writes the stacks of all the goroutines for diagnosing
+// what blocked the shutdown. Nothing is torn down: lnd runs in process and may
+// still write to its databases, they are released once it exits.
+func (d *Daemon) dumpStuckShutdown() {
+	d.log.Errorf("daemon didn't shut down within %v", d.shutdownTimeout())
+	dumpPath := path.Join(d.cfg.WorkingDir, shutdownDumpFile)
+	if f, err := os.Create(dumpPath); err != nil {
+		d.log.Errorf("os.Create(%v): %v", dumpPath, err)
+	} else {
+		if err := pprof.Lookup("goroutine").WriteTo(f, 2); err != nil {
+			d.log.Errorf("failed to write the goroutines dump: %v", err)
+		}
+		f.Close()
+	}
+}
+
+// onStuckShutdownExit marks the daemon as stopped once lnd exited after a
+// shutdown that timed out, so it can't be started twice.
+func (d *Daemon) onStuckShutdownExit() {
+	d.wg.Wait()
+	d.Lock()
+	defer d.Unlock()
+	d.daemonRunning = false
+	d.ntfnServer.SendUpdate(DaemonDownEvent{})
+	d.log.Infof("Daemon exited after a stuck shutdown, sent down event")
+}
@@ -0,0 +1,105 @@
+package lnnode
+
+import (
+	"context"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/breez/breez/data"
+)
+
+const (
+	storageCheckInterval = time.Hour
+
+	// The directories of the subsystems, relative to the working directory.
+	channelDBDirPattern = "data/graph/{{network}}"
+	neutrinoDirPattern  = "data/chain/bitcoin/{{network}}"
+	logsDir             = "logs"
+	backupsDir          = "backup"
+	breezDBFile         = "breez.db"
+)
+
+// neutrinoFiles are the files of neutrino in its directory, which holds the
+// lnd wallet and macaroons as well.
+var neutrinoFiles = []string{"neutrino.db", "block_headers.bin", "reg_filter_headers.bin"}
+
+// StorageQuotaExceededEvent is sent when the working directory grows beyond
+// the configured storage quota.
+type StorageQuotaExceededEvent struct {
+	*data.StorageReport
+}
+
+// StorageReport returns the disk space used by the working directory and by
+// each subsystem.
+func (d *Daemon) StorageReport() (*data.StorageReport, error) {
+	workingDir := d.cfg.WorkingDir
+	info, err := os.Stat(workingDir)
+	if err != nil {
+		return nil, err
+	}
+	report := &data.StorageReport{
+		Total:     d.du(workingDir, info),
+		ChannelDb: d.pathSize(channelDBDirPattern),
+		Neutrino:  d.neutrinoSize(),
+		Logs:      d.pathSize(logsDir),
+		Backups:   d.pathSize(backupsDir),
+		BreezDb:   d.pathSize(breezDBFile),
+		Quota:     d.cfg.StorageCfg.Quota,
+	}
+	report.Other = report.Total - report.ChannelDb - report.Neutrino -
+		report.Logs - report.Backups - report.BreezDb
+	report.QuotaExceeded = report.Quota > 0 && report.Total > report.Quota
+	return report, nil
+}
+
+// pathSize returns the size of the file or directory relative to the working
+// directory, or zero if it doesn't exist.
+func (d *Daemon) pathSize(pattern string) int64 {
+	p := path.Join(d.cfg.WorkingDir, strings.Replace(pattern, "{{network}}", d.cfg.Network, -1))
+	info, err := os.Stat(p)
+	if err != nil {
+		return 0
+	}
+	return d.du(p, info)
+}
+
+// neutrinoSize returns the size of the neutrino files.
+func (d *Daemon) neutrinoSize() int64 {
+	var size int64
+	for _, f := range neutrinoFiles {
+		size += d.pathSize(path.Join(neutrinoDirPattern, f))
+	}
+	return size
+}
+
+// watchStorage checks the storage used against the quota and sends a
+// StorageQuotaExceededEvent once each time it is exceeded.
+func (d *Daemon) watchStorage(ctx context.Context) {
+	defer d.wg.Done()
+	if d.cfg.StorageCfg.Quota <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(storageCheckInterval)
+	defer ticker.Stop()
+	exceeded := false
+	for {
+		report, err := d.StorageReport()
+		if err != nil {
+			d.log.Errorf("watchStorage failed to get the storage report: %v", err)
+		} else if report.QuotaExceeded != exceeded {
+			exceeded = report.QuotaExceeded
+			if exceeded {
+				d.log.Warnf("storage quota exceeded: %v", report)
+				d.ntfnServer.SendUpdate(StorageQuotaExceededEvent{report})
+			}
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/breez/boltz"
 	breezservice "github.com/breez/breez/breez"
+	"github.com/breez/breez/chainservice"
 	"github.com/breez/breez/channeldbservice"
 	"github.com/breez/breez/data"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -20,6 +21,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc/chainrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/zpay32"
 )
 
@@ -198,20 +200,22 @@ func (s *Service) handleClaimTransaction() error {
 	return nil
 }
 
+// ClaimFeeEstimates returns the fee of the claim transaction to confirm within
+// 2, 6 and 24 blocks, keyed by the conf target.
 func (s *Service) ClaimFeeEstimates(claimAddress string) (map[int32]int64, error) {
 	blockRange := []int32{2, 6, 24}
-	walletKitClient := s.daemonAPI.WalletKitClient()
+	tiers, err := s.feeRates(blockRange)
+	if err != nil {
+		s.log.Errorf("s.feeRates(%v): %v", blockRange, err)
+		return nil, fmt.Errorf("s.feeRates(%v): %w", blockRange, err)
+	}
 	fees := make(map[int32]int64)
-	for _, b := range blockRange {
-		f, err := walletKitClient.EstimateFee(context.Background(), &walletrpc.EstimateFeeRequest{ConfTarget: b})
-		if err != nil {
-			s.log.Errorf("walletKitClient.EstimateFee(%v): %v", b, err)
-			return nil, fmt.Errorf("walletKitClient.EstimateFee(%v): %w", b, err)
-		}
-		fAmt, err := boltz.ClaimFee(claimAddress, f.SatPerKw)
+	for b, satPerVbyte := range tiers {
+		satPerKw := int64(chainfee.SatPerKVByte(satPerVbyte * 1000).FeePerKWeight())
+		fAmt, err := boltz.ClaimFee(claimAddress, satPerKw)
 		if err != nil {
-			s.log.Errorf("boltz.ClaimFee(%v, %v): %v", claimAddress, f.SatPerKw, err)
-			return nil, fmt.Errorf("boltz.ClaimFee(%v, %v): %w", claimAddress, f.SatPerKw, err)
+			s.log.Errorf("boltz.ClaimFee(%v, %v): %v", claimAddress, satPerKw, err)
+			return nil, fmt.Errorf("boltz.ClaimFee(%v, %v): %w", claimAddress, satPerKw, err)
 		}
 		fees[b] = fAmt
 	}
@@ -256,36 +260,20 @@ func (s *Service) subscribeLockupScript(rs *data.ReverseSwap) error {
 	if err != nil {
 		return fmt.Errorf("txscript.PayToAddrScript(%v) %w", a, err)
 	}
-	client := s.daemonAPI.ChainNotifierClient()
 	ctx, cancel := context.WithCancel(context.Background())
 	startHeight := uint32(rs.StartBlockHeight)
 	s.log.Infof("Registering with start block = %v", startHeight)
-	stream, err := client.RegisterConfirmationsNtfn(ctx, &chainrpc.ConfRequest{
-		NumConfs:   1,
-		HeightHint: startHeight,
-		Txid:       lntypes.ZeroHash[:],
-		Script:     script,
-	})
+	confirmed, err := chainservice.WatchScript(ctx, s.daemonAPI, script, 1, startHeight)
 	if err != nil {
-		s.log.Errorf("client.RegisterConfirmationsNtfn(%v, %x): %v", startHeight, script, err)
+		s.log.Errorf("chainservice.WatchScript(%v, %x): %v", startHeight, script, err)
 		cancel()
-		return fmt.Errorf("client.RegisterConfirmationsNtfn(%v, %x): %w", startHeight, script, err)
+		return fmt.Errorf("chainservice.WatchScript(%v, %x): %w", startHeight, script, err)
 	}
-	//fmt.Printf("Register: %#v\n", rs)
 	go func() {
-		for {
-			confEvent, err := stream.Recv()
-			if err != nil {
-				s.log.Errorf("Failed to receive an event : %v", err)
-				time.AfterFunc(time.Second*10, func() {
-					s.subscribeLockupScript(rs)
-				})
-				s.onServiceEvent(data.NotificationEvent{Type: data.NotificationEvent_REVERSE_SWAP_CLAIM_FAILED, Data: []string{rs.Key, "internal error"}})
-				return
-			}
-			s.log.Infof("confEvent: %#v; rawTX:%x", confEvent.GetConf(), confEvent.GetConf().GetRawTx())
+		for conf := range confirmed {
+			s.log.Infof("confEvent: %#v; rawTX:%x", conf, conf.GetRawTx())
 			s.onServiceEvent(data.NotificationEvent{Type: data.NotificationEvent_REVERSE_SWAP_CLAIM_STARTED, Data: []string{rs.Key}})
-			err = s.claimReverseSwap(rs, confEvent.GetConf().GetRawTx())
+			err = s.claimReverseSwap(rs, conf.GetRawTx())
 			if err != nil {
 				s.onServiceEvent(data.NotificationEvent{Type: data.NotificationEvent_REVERSE_SWAP_CLAIM_FAILED, Data: []string{rs.Key, err.Error()}})
 			} else {
@@ -297,7 +285,6 @@ func (s *Service) subscribeLockupScript(rs *data.ReverseSwap) error {
 		select {
 		case <-ctx.Done():
 			s.log.Infof("Cancelling subscribeLockupScript")
-			cancel()
 		case <-s.quitChan:
 			cancel()
 		}
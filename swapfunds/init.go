@@ -29,6 +29,7 @@ type Service struct {
 	addInvoice            func(invoiceRequest *data.AddInvoiceRequest) (paymentRequest string, lspFee int64, err error)
 	lspList               func() (*data.LSPList, error)
 	getGlobalReceiveLimit func() (maxReceive int64, err error)
+	feeRates              func(confTargets []int32) (map[int32]int64, error)
 	onServiceEvent        func(data.NotificationEvent)
 	quitChan              chan struct{}
 }
@@ -42,6 +43,7 @@ func NewService(
 	addInvoice func(invoiceRequest *data.AddInvoiceRequest) (paymentRequest string, lspFee int64, err error),
 	lspList func() (*data.LSPList, error),
 	getGlobalReceiveLimit func() (maxReceive int64, err error),
+	feeRates func(confTargets []int32) (map[int32]int64, error),
 	onServiceEvent func(data.NotificationEvent)) (*Service, error) {
 
 	logger, err := breezlog.GetLogger(cfg.WorkingDir, "FUNDS")
@@ -67,6 +69,7 @@ func NewService(
 		addInvoice:            addInvoice,
 		lspList:               lspList,
 		getGlobalReceiveLimit: getGlobalReceiveLimit,
+		feeRates:              feeRates,
 		onServiceEvent:        onServiceEvent,
 		log:                   logger,
 		daemonAPI:             daemonAPI,
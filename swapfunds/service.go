@@ -51,7 +51,7 @@ func (s *Service) watchDaemonEvents() (err error) {
 				s.SettlePendingTransfers()
 			case lnnode.PeerEvent:
 				s.SettlePendingTransfers()
-			case lnnode.TransactionEvent:
+			case lnnode.TransactionEvent, lnnode.ChainReorgEvent:
 				s.onTransaction()
 			case lnnode.DaemonDownEvent:
 				return nil
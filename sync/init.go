@@ -12,13 +12,14 @@ import (
 Job contains a running job info.
 */
 type Job struct {
-	workingDir string
-	network    string
-	config     config.JobConfig
-	shutdown   int32
-	log        btclog.Logger
-	wg         sync.WaitGroup
-	quit       chan struct{}
+	workingDir      string
+	network         string
+	config          config.JobConfig
+	shutdown        int32
+	budgetExhausted int32
+	log             btclog.Logger
+	wg              sync.WaitGroup
+	quit            chan struct{}
 }
 
 /*
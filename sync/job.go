@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/breez/breez/chainservice"
+	"github.com/breez/breez/config"
 	"github.com/breez/breez/db"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/neutrino"
@@ -33,6 +34,27 @@ func (s *Job) Run() (channelClosed bool, err error) {
 	return res, nil
 }
 
+/*
+SyncChainFor executes the download filter operation synchronousely but stops
+once budget has elapsed. The progress made is kept so the next run resumes
+from it. Running out of budget is not an error.
+*/
+func (s *Job) SyncChainFor(budget time.Duration) (channelClosed bool, err error) {
+	timer := time.AfterFunc(budget, func() {
+		s.log.Infof("sync budget of %v exhausted, stopping the job", budget)
+		atomic.StoreInt32(&s.budgetExhausted, 1)
+		s.terminate()
+	})
+	defer timer.Stop()
+
+	channelClosed, err = s.Run()
+	if err != nil && atomic.LoadInt32(&s.budgetExhausted) == 1 {
+		s.log.Infof("sync stopped before completion: %v", err)
+		return false, nil
+	}
+	return channelClosed, err
+}
+
 /*
 Stop stops neutrino instance and wait for the syncFitlers to complete
 */
@@ -67,6 +89,14 @@ func (s *Job) syncFilters() (channelClosed bool, err error) {
 		s.log.Info("syncFilters started needs bootstrap, skiping job")
 		return false, nil
 	}
+	config, err := config.GetConfig(s.workingDir)
+	if err != nil {
+		return false, err
+	}
+	if config.BitcoindCfg.RPCHost != "" {
+		s.log.Info("syncFilters skipped, the chain backend is bitcoind")
+		return false, nil
+	}
 
 	breezDB, cleanupDB, err := db.Get(s.workingDir)
 	if err != nil {
@@ -152,6 +182,16 @@ syncHeaders:
 			return false, nil
 		}
 
+		// Don't fetch filters on a metered connection, resume when it
+		// isn't metered anymore.
+		if chainservice.IsMetered() {
+			s.log.Infof("connection is metered, pausing filters sync at height %v", currentHeight)
+			if !chainservice.WaitUnmetered(s.quit) {
+				return false, nil
+			}
+			s.log.Infof("connection is not metered, resuming filters sync")
+		}
+
 		// Get block hash
 		h, err := chainService.GetBlockHash(int64(currentHeight))
 		if err != nil {